@@ -0,0 +1,365 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// unitSynonyms lists the spellings a parser accepts for a unit
+type unitSynonyms struct {
+	unit      Category
+	spellings []string
+}
+
+// parserSynonyms holds, per dimension, the built-in spellings accepted by the
+// dimension's parser (e.g. ParseLength), primary spelling first. Registered
+// aliases are kept separately in unitAliases.
+var parserSynonyms = map[string][]unitSynonyms{
+	"acceleration": {
+		{Acceleration.MetersPerSecondSquared, []string{"m/s²", "m/s2", "meters per second squared"}},
+		{Acceleration.G, []string{"g", "g-force"}},
+		{Acceleration.FeetPerSecondSquared, []string{"ft/s²", "ft/s2", "feet per second squared"}},
+	},
+	"angle": {
+		{Angle.Radian, []string{"rad", "radian", "radians"}},
+		{Angle.Degree, []string{"°", "deg", "degree", "degrees"}},
+		{Angle.Arcminute, []string{"′", "arcmin", "arcminute", "arcminutes"}},
+		{Angle.Arcsecond, []string{"″", "arcsec", "arcsecond", "arcseconds"}},
+		{Angle.Revolution, []string{"rev", "revolution", "revolutions"}},
+		{Angle.Gradian, []string{"grad", "gradian", "gradians"}},
+	},
+	"area": {
+		{Area.SquareMeter, []string{"m²", "m2", "sq m", "square meter", "square meters"}},
+		{Area.SquareKilometer, []string{"km²", "km2", "sq km", "square kilometer", "square kilometers"}},
+		{Area.SquareCentimeter, []string{"cm²", "cm2", "sq cm", "square centimeter", "square centimeters"}},
+		{Area.SquareMillimeter, []string{"mm²", "mm2", "sq mm", "square millimeter", "square millimeters"}},
+		{Area.SquareInch, []string{"in²", "in2", "sq in", "square inch", "square inches"}},
+		{Area.SquareFoot, []string{"ft²", "ft2", "sq ft", "square foot", "square feet"}},
+		{Area.SquareYard, []string{"yd²", "yd2", "sq yd", "square yard", "square yards"}},
+		{Area.SquareMile, []string{"mi²", "mi2", "sq mi", "square mile", "square miles"}},
+		{Area.Acre, []string{"ac", "acre", "acres"}},
+		{Area.Hectare, []string{"ha", "hectare", "hectares"}},
+	},
+	"concentration": {
+		{Concentration.GramsPerLiter, []string{"g/l", "grams per liter"}},
+		{Concentration.MilligramsPerLiter, []string{"mg/l", "milligrams per liter"}},
+		{Concentration.PartsPerMillion, []string{"ppm", "parts per million"}},
+		{Concentration.PartsPerBillion, []string{"ppb", "parts per billion"}},
+		{Concentration.GrainsPerGallon, []string{"gpg", "grains per gallon", "grain per gallon"}},
+	},
+	"dispersion": {
+		{Dispersion.PartsPerMillion, []string{"ppm", "parts per million"}},
+		{Dispersion.PartsPerBillion, []string{"ppb", "parts per billion"}},
+		{Dispersion.PartsPerTrillion, []string{"ppt", "parts per trillion"}},
+	},
+	"duration": {
+		{Duration.Second, []string{"s", "sec", "second", "seconds"}},
+		{Duration.Minute, []string{"min", "minute", "minutes"}},
+		{Duration.Hour, []string{"h", "hr", "hour", "hours"}},
+		{Duration.Day, []string{"d", "day", "days"}},
+		{Duration.Millisecond, []string{"ms", "millisecond", "milliseconds"}},
+		{Duration.Microsecond, []string{"µs", "us", "microsecond", "microseconds"}},
+		{Duration.Nanosecond, []string{"ns", "nanosecond", "nanoseconds"}},
+	},
+	"electric_charge": {
+		{ElectricCharge.Coulomb, []string{"c", "coulomb", "coulombs"}},
+		{ElectricCharge.Millicoulomb, []string{"mc", "millicoulomb", "millicoulombs"}},
+		{ElectricCharge.Microcoulomb, []string{"µc", "uc", "microcoulomb", "microcoulombs"}},
+		{ElectricCharge.Ampere_Hour, []string{"ah", "ampere-hour", "ampere-hours"}},
+		{ElectricCharge.Milliampere_Hour, []string{"mah", "milliampere-hour", "milliampere-hours"}},
+	},
+	"electric_current": {
+		{ElectricCurrent.Ampere, []string{"a", "ampere", "amperes"}},
+		{ElectricCurrent.Milliampere, []string{"ma", "milliampere", "milliamperes"}},
+		{ElectricCurrent.Microampere, []string{"µa", "ua", "microampere", "microamperes"}},
+		{ElectricCurrent.Kiloampere, []string{"ka", "kiloampere", "kiloamperes"}},
+	},
+	"electric_potential_difference": {
+		{ElectricPotentialDifference.Volt, []string{"v", "volt", "volts"}},
+		{ElectricPotentialDifference.Millivolt, []string{"mv", "millivolt", "millivolts"}},
+		{ElectricPotentialDifference.Microvolt, []string{"µv", "uv", "microvolt", "microvolts"}},
+		{ElectricPotentialDifference.Kilovolt, []string{"kv", "kilovolt", "kilovolts"}},
+		{ElectricPotentialDifference.Megavolt, []string{"megav", "megavolt", "megavolts"}},
+	},
+	"information": {
+		{Information.Bit, []string{"bit", "bits"}},
+		{Information.Byte, []string{"byte", "bytes"}},
+		{Information.Kilobyte, []string{"kilobyte", "kilobytes"}},
+		{Information.Megabyte, []string{"megabyte", "megabytes"}},
+		{Information.Gigabyte, []string{"gigabyte", "gigabytes"}},
+		{Information.Terabyte, []string{"terabyte", "terabytes"}},
+		{Information.Petabyte, []string{"petabyte", "petabytes"}},
+		{Information.Kibibyte, []string{"kibibyte", "kibibytes"}},
+		{Information.Mebibyte, []string{"mebibyte", "mebibytes"}},
+		{Information.Gibibyte, []string{"gibibyte", "gibibytes"}},
+		{Information.Tebibyte, []string{"tebibyte", "tebibytes"}},
+		{Information.Pebibyte, []string{"pebibyte", "pebibytes"}},
+		{Information.Kibibit, []string{"kibit", "kibibit", "kibibits"}},
+		{Information.Mebibit, []string{"mibit", "mebibit", "mebibits"}},
+		{Information.Gibibit, []string{"gibit", "gibibit", "gibibits"}},
+	},
+	"length": {
+		{Length.Meter, []string{"m", "meter"}},
+		{Length.Kilometer, []string{"km", "kilometer", "kilometers"}},
+		{Length.Centimeter, []string{"cm", "centimeter", "centimeters"}},
+		{Length.Millimeter, []string{"mm", "millimeter", "millimeters"}},
+		{Length.Micrometer, []string{"µm", "um", "micrometer", "micrometers"}},
+		{Length.Nanometer, []string{"nm", "nanometer", "nanometers"}},
+		{Length.Inch, []string{"in", "inch", "inches"}},
+		{Length.Foot, []string{"ft", "foot", "feet"}},
+		{Length.Yard, []string{"yd", "yard", "yards"}},
+		{Length.Mile, []string{"mi", "mile", "miles"}},
+	},
+	"magnetic_flux_density": {
+		{MagneticFluxDensity.Tesla, []string{"t", "tesla", "teslas"}},
+		{MagneticFluxDensity.Millitesla, []string{"mt", "millitesla", "milliteslas"}},
+		{MagneticFluxDensity.Microtesla, []string{"µt", "ut", "microtesla", "microteslas"}},
+		{MagneticFluxDensity.Gauss, []string{"g", "gs", "gauss"}},
+	},
+	"mass": {
+		{Mass.Kilogram, []string{"kg", "kilogram", "kilograms"}},
+		{Mass.Gram, []string{"g", "gram", "grams"}},
+		{Mass.Milligram, []string{"mg", "milligram", "milligrams"}},
+		{Mass.Microgram, []string{"µg", "ug", "microgram", "micrograms"}},
+		{Mass.Pound, []string{"lb", "pound", "pounds"}},
+		{Mass.Ounce, []string{"oz", "ounce", "ounces"}},
+		{Mass.Stone, []string{"st", "stone", "stones"}},
+		{Mass.MetricTon, []string{"t", "metric ton", "metric tons"}},
+		{Mass.Ton, []string{"ton", "tons", "short ton", "short tons"}},
+		{Mass.LongTon, []string{"long ton", "long tons", "imperial ton", "imperial tons"}},
+	},
+	"pressure": {
+		{Pressure.Pascal, []string{"pa", "pascal"}},
+		{Pressure.Kilopascal, []string{"kpa", "kilopascal"}},
+		{Pressure.Bar, []string{"bar"}},
+		{Pressure.PSI, []string{"psi"}},
+		{Pressure.InchH2O, []string{"inh2o", "inh₂o", "inch water"}},
+		{Pressure.InchHg, []string{"inhg", "in hg", "inch mercury", "inches of mercury"}},
+	},
+	"speed": {
+		{Speed.MetersPerSecond, []string{"m/s", "meters per second", "metres per second"}},
+		{Speed.KilometersPerHour, []string{"km/h", "kilometers per hour", "kilometres per hour"}},
+		{Speed.MilesPerHour, []string{"mph", "miles per hour"}},
+		{Speed.FeetPerSecond, []string{"ft/s", "feet per second", "foot per second"}},
+		{Speed.Knot, []string{"kn", "knot", "knots"}},
+		{Speed.FeetPerMinute, []string{"fpm", "ft/min", "feet per minute", "foot per minute"}},
+	},
+	"temperature": {
+		// "deg" spellings must name the scale; a bare "deg" is an angle
+		{Temperature.Celsius, []string{"c", "°c", "celsius", "degc", "deg c", "deg celsius", "degree c", "degrees c", "degree celsius", "degrees celsius"}},
+		{Temperature.Fahrenheit, []string{"f", "°f", "fahrenheit", "degf", "deg f", "deg fahrenheit", "degree f", "degrees f", "degree fahrenheit", "degrees fahrenheit"}},
+		{Temperature.Kelvin, []string{"k", "kelvin"}},
+	},
+	"volume": {
+		{Volume.CubicMeter, []string{"m³", "m3", "cu m", "cubic meter", "cubic meters"}},
+		{Volume.CubicKilometer, []string{"km³", "km3", "cu km", "cubic kilometer", "cubic kilometers"}},
+		{Volume.CubicCentimeter, []string{"cm³", "cm3", "cc", "cu cm", "cubic centimeter", "cubic centimeters"}},
+		{Volume.CubicMillimeter, []string{"mm³", "mm3", "cu mm", "cubic millimeter", "cubic millimeters"}},
+		{Volume.Liter, []string{"l", "liter", "liters"}},
+		{Volume.Milliliter, []string{"ml", "milliliter", "milliliters", "millilitre", "millilitres"}},
+		{Volume.CubicInch, []string{"in³", "in3", "cu in", "cubic inch", "cubic inches"}},
+		{Volume.CubicFoot, []string{"ft³", "ft3", "cu ft", "cubic foot", "cubic feet"}},
+		{Volume.CubicYard, []string{"yd³", "yd3", "cu yd", "cubic yard", "cubic yards"}},
+		{Volume.Gallon, []string{"gal", "gallon", "gallons"}},
+		{Volume.Quart, []string{"qt", "quart", "quarts"}},
+		{Volume.Pint, []string{"pt", "pint", "pints"}},
+		{Volume.Cup, []string{"cup", "cups"}},
+		{Volume.FluidOunce, []string{"fl oz", "fluid ounce", "fluid ounces"}},
+		{Volume.ImperialPint, []string{"imp pt", "imperial pint", "imperial pints"}},
+		{Volume.ImperialFluidOunce, []string{"imp fl oz", "imperial fluid ounce", "imperial fluid ounces"}},
+		{Volume.BoardFoot, []string{"bf", "fbm", "board foot", "board feet"}},
+	},
+}
+
+// parserSpellings indexes parserSynonyms by dimension and spelling
+var parserSpellings = indexSynonyms(parserSynonyms)
+
+// exactSynonyms holds, per dimension, the built-in spellings whose case
+// carries meaning and which are therefore matched exactly: "b" is a bit and
+// "B" a byte, so "Mb" must not be read as a megabyte nor "Kib" as a kibibyte.
+// Their lower-cased forms are deliberately absent from parserSynonyms.
+var exactSynonyms = map[string][]unitSynonyms{
+	"information": {
+		{Information.Bit, []string{"b"}},
+		{Information.Byte, []string{"B"}},
+		{Information.Kilobyte, []string{"KB", "kB"}},
+		{Information.Megabyte, []string{"MB"}},
+		{Information.Gigabyte, []string{"GB"}},
+		{Information.Terabyte, []string{"TB"}},
+		{Information.Petabyte, []string{"PB"}},
+		{Information.Kibibyte, []string{"KiB"}},
+		{Information.Mebibyte, []string{"MiB"}},
+		{Information.Gibibyte, []string{"GiB"}},
+		{Information.Tebibyte, []string{"TiB"}},
+		{Information.Pebibyte, []string{"PiB"}},
+	},
+}
+
+// exactSpellings indexes exactSynonyms by dimension and spelling
+var exactSpellings = indexSynonyms(exactSynonyms)
+
+// indexSynonyms maps each spelling of each dimension to its unit
+func indexSynonyms(synonyms map[string][]unitSynonyms) map[string]map[string]Category {
+	index := make(map[string]map[string]Category, len(synonyms))
+	for dimension, entries := range synonyms {
+		index[dimension] = make(map[string]Category)
+		for _, entry := range entries {
+			for _, spelling := range entry.spellings {
+				index[dimension][spelling] = entry.unit
+			}
+		}
+	}
+	return index
+}
+
+// unitAliases maps a dimension to its lower-cased aliases and the canonical
+// unit symbol each alias stands for
+var unitAliases = struct {
+	sync.RWMutex
+	byDimension map[string]map[string]string
+}{byDimension: map[string]map[string]string{
+	"area_density": {"gsm": "g/m²"},
+	"length":       {"meters": "m"},
+	"mass":         {"tonne": "t", "tonnes": "t"},
+	"volume":       {"litre": "L", "litres": "L"},
+	"speed":        {"kph": "km/h", "kt": "kn", "kts": "kn"},
+}}
+
+// RegisterAlias registers alias as a synonym for the unit with
+// canonicalSymbol in dimension, so that the parsers (e.g. ParseLength) and
+// NewFromStrings accept it, e.g. RegisterAlias("length", "clicks", "km").
+// Aliases are matched case-insensitively. It panics if the dimension or the
+// canonical unit is not registered, or if alias is a built-in spelling or
+// symbol of a different unit of the dimension (e.g. "m" for anything but the
+// meter), which it would otherwise silently take over.
+func RegisterAlias(dimension, alias, canonicalSymbol string) {
+	r, ok := registries[dimension]
+	if !ok {
+		panic(fmt.Sprintf("unit: cannot register alias %q for unknown dimension %q", alias, dimension))
+	}
+	u, ok := r.resolve(canonicalSymbol)
+	if !ok {
+		panic(fmt.Sprintf("unit: cannot register alias %q for unknown %s unit %q", alias, dimension, canonicalSymbol))
+	}
+	if builtin, ok := conflictingSpelling(dimension, alias, u); ok {
+		panic(fmt.Sprintf("unit: cannot register alias %q for %s: it already names %s", alias, u.Symbol(), builtin.Symbol()))
+	}
+
+	unitAliases.Lock()
+	defer unitAliases.Unlock()
+	aliases, ok := unitAliases.byDimension[dimension]
+	if !ok {
+		aliases = make(map[string]string)
+		unitAliases.byDimension[dimension] = aliases
+	}
+	aliases[strings.ToLower(strings.TrimSpace(alias))] = u.Symbol()
+}
+
+// conflictingSpelling returns a unit of dimension other than u that s names
+// as a built-in parser spelling or registered symbol, compared
+// case-insensitively as aliases are. Spellings that differ only in case, such
+// as "b" and "B", may name different units, so every match is checked.
+func conflictingSpelling(dimension, s string, u Category) (Category, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if builtin, ok := parserSpellings[dimension][s]; ok && !builtin.Equals(u) {
+		return builtin, true
+	}
+	for spelling, builtin := range exactSpellings[dimension] {
+		if strings.ToLower(spelling) == s && !builtin.Equals(u) {
+			return builtin, true
+		}
+	}
+	for symbol, builtin := range registries[dimension].bySymbol {
+		if strings.ToLower(symbol) == s && !builtin.Equals(u) {
+			return builtin, true
+		}
+	}
+	return nil, false
+}
+
+// resolveAlias returns the canonical unit symbol registered for alias in dimension
+func resolveAlias(dimension, alias string) (string, bool) {
+	unitAliases.RLock()
+	defer unitAliases.RUnlock()
+	symbol, ok := unitAliases.byDimension[dimension][strings.ToLower(strings.TrimSpace(alias))]
+	return symbol, ok
+}
+
+// aliasedUnit returns the unit of dimension that unitStr is a registered alias for
+func aliasedUnit[T Category](dimension, unitStr string) (T, bool) {
+	symbol, ok := resolveAlias(dimension, unitStr)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	unit, err := lookupUnit[T](dimension, symbol)
+	return unit, err == nil
+}
+
+// parserUnit returns the unit of dimension that unitStr names, checking
+// registered aliases before the built-in spellings. Matching is
+// case-insensitive except for the spellings in exactSynonyms.
+func parserUnit[T Category](dimension, unitStr string) (T, bool) {
+	if unit, ok := aliasedUnit[T](dimension, unitStr); ok {
+		return unit, true
+	}
+	if unit, ok := exactSpellings[dimension][unitStr].(T); ok {
+		return unit, true
+	}
+	unit, ok := parserSpellings[dimension][strings.ToLower(unitStr)].(T)
+	return unit, ok
+}
+
+// UnitAliases returns every string the parser for dimension accepts for the
+// unit with the given symbol: the unit's symbol first, then its built-in
+// spellings, then any aliases added with RegisterAlias, e.g. ["m", "meter",
+// "meters"] for the meter. Spellings other than the symbol are lower-case,
+// as matching is case-insensitive, except where case distinguishes units
+// (e.g. "B" for the byte). It returns nil if the unit is unknown.
+func UnitAliases(dimension, symbol string) []string {
+	r, ok := registries[dimension]
+	if !ok {
+		return nil
+	}
+	u, ok := r.resolve(symbol)
+	if !ok {
+		return nil
+	}
+
+	aliases := []string{u.Symbol()}
+	seen := map[string]bool{u.Symbol(): true, strings.ToLower(u.Symbol()): true}
+	add := func(spelling string) {
+		if !seen[spelling] {
+			seen[spelling] = true
+			aliases = append(aliases, spelling)
+		}
+	}
+
+	for _, entries := range [][]unitSynonyms{exactSynonyms[dimension], parserSynonyms[dimension]} {
+		for _, entry := range entries {
+			if entry.unit.Equals(u) {
+				for _, spelling := range entry.spellings {
+					add(spelling)
+				}
+			}
+		}
+	}
+
+	unitAliases.RLock()
+	registered := make([]string, 0)
+	for alias, aliasSymbol := range unitAliases.byDimension[dimension] {
+		if aliasSymbol == u.Symbol() {
+			registered = append(registered, alias)
+		}
+	}
+	unitAliases.RUnlock()
+	sort.Strings(registered)
+	for _, alias := range registered {
+		add(alias)
+	}
+	return aliases
+}
@@ -0,0 +1,141 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSeededAliases(t *testing.T) {
+	if speed, err := ParseSpeed("90 kph"); err != nil || !speed.Unit.Equals(Speed.KilometersPerHour) {
+		t.Errorf("ParseSpeed(\"90 kph\") = %v, %v; expected 90 km/h", speed, err)
+	}
+	if volume, err := ParseVolume("2 Litres"); err != nil || !volume.Unit.Equals(Volume.Liter) {
+		t.Errorf("ParseVolume(\"2 Litres\") = %v, %v; expected 2 L", volume, err)
+	}
+	if length, err := ParseLength("3 meters"); err != nil || !length.Unit.Equals(Length.Meter) {
+		t.Errorf("ParseLength(\"3 meters\") = %v, %v; expected 3 m", length, err)
+	}
+	if speed, err := NewFromStrings[SpeedUnit]("90", "kph"); err != nil || !speed.Unit.Equals(Speed.KilometersPerHour) {
+		t.Errorf("NewFromStrings(\"90\", \"kph\") = %v, %v; expected 90 km/h", speed, err)
+	}
+}
+
+func TestRegisterAlias(t *testing.T) {
+	RegisterAlias("length", "clicks", "km")
+
+	length, err := ParseLength("5 clicks")
+	if err != nil {
+		t.Fatalf("ParseLength(\"5 clicks\"): %v", err)
+	}
+	if length.Value != 5 || !length.Unit.Equals(Length.Kilometer) {
+		t.Errorf("ParseLength(\"5 clicks\") = %v, expected 5 km", length)
+	}
+
+	// Aliases are case-insensitive and reach the generic parsers too
+	length, err = ParseQuantity[LengthUnit]("5 Clicks")
+	if err != nil || !length.Unit.Equals(Length.Kilometer) {
+		t.Errorf("ParseQuantity(\"5 Clicks\") = %v, %v; expected 5 km", length, err)
+	}
+	unit, err := lookupUnit[LengthUnit]("length", "clicks")
+	if err != nil || !unit.Equals(Length.Kilometer) {
+		t.Errorf("lookupUnit(\"length\", \"clicks\") = %v, %v; expected km", unit, err)
+	}
+
+	// Aliases are scoped to their dimension
+	if _, err := ParseSpeed("5 clicks"); err == nil {
+		t.Error("Expected error for length alias parsed as speed")
+	}
+}
+
+func TestRegisterAliasPanicsOnUnknownUnit(t *testing.T) {
+	testCases := []struct {
+		dimension string
+		symbol    string
+	}{
+		{"length", "parsec"},
+		{"torque", "N·m"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterAlias(%q, \"x\", %q): expected panic", tc.dimension, tc.symbol)
+				}
+			}()
+			RegisterAlias(tc.dimension, "x", tc.symbol)
+		}()
+	}
+}
+
+func TestRegisterAliasRejectsBuiltinSpellings(t *testing.T) {
+	// Each alias already names another unit of its dimension
+	testCases := []struct {
+		dimension, alias, symbol string
+	}{
+		{"length", "m", "km"},      // symbol of the meter
+		{"length", "Meter", "km"},  // built-in spelling, any case
+		{"temperature", "c", "K"},  // °C
+		{"information", "b", "B"},  // bit, matched exactly by the parser
+		{"information", "mb", "B"}, // megabyte
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterAlias(%q, %q, %q): expected panic", tc.dimension, tc.alias, tc.symbol)
+				}
+			}()
+			RegisterAlias(tc.dimension, tc.alias, tc.symbol)
+		}()
+	}
+
+	if u, err := ParseLength("5 m"); err != nil || !u.Unit.Equals(Length.Meter) {
+		t.Errorf("ParseLength(\"5 m\") = %v, %v; expected 5 m", u, err)
+	}
+
+	// Repeating a built-in spelling for the same unit is harmless
+	RegisterAlias("length", "meter", "m")
+}
+
+func TestUnitAliases(t *testing.T) {
+	testCases := []struct {
+		dimension, symbol string
+		expected          []string
+	}{
+		{"length", "m", []string{"m", "meter", "meters"}},
+		{"length", "mi", []string{"mi", "mile", "miles"}},
+		{"temperature", "°C", []string{"°C", "c", "celsius", "degc", "deg c", "deg celsius", "degree c", "degrees c", "degree celsius", "degrees celsius"}},
+		{"speed", "kn", []string{"kn", "knot", "knots", "kt", "kts"}},
+	}
+	for _, tc := range testCases {
+		got := UnitAliases(tc.dimension, tc.symbol)
+		if strings.Join(got, "|") != strings.Join(tc.expected, "|") {
+			t.Errorf("UnitAliases(%q, %q) = %q, expected %q", tc.dimension, tc.symbol, got, tc.expected)
+		}
+	}
+
+	// Every alias of every unit parses back to that unit
+	for _, dimension := range ListDimensions() {
+		for _, info := range ListUnits(dimension) {
+			aliases := UnitAliases(dimension, info.Symbol)
+			if len(aliases) == 0 || aliases[0] != info.Symbol {
+				t.Errorf("UnitAliases(%q, %q) = %q, expected the symbol first", dimension, info.Symbol, aliases)
+			}
+			for _, alias := range aliases[1:] {
+				unit, ok := parserUnit[Category](dimension, alias)
+				if !ok || unit.Symbol() != info.Symbol {
+					t.Errorf("alias %q of %s %s does not parse back", alias, dimension, info.Symbol)
+				}
+			}
+		}
+	}
+
+	if got := UnitAliases("length", "parsec"); got != nil {
+		t.Errorf("UnitAliases for an unknown unit = %q, expected nil", got)
+	}
+	if got := UnitAliases("torque", "N·m"); got != nil {
+		t.Errorf("UnitAliases for an unknown dimension = %q, expected nil", got)
+	}
+}
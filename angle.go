@@ -2,7 +2,10 @@
 // physical quantities with units.
 package unit
 
-import "math"
+import (
+	"math"
+	"strconv"
+)
 
 // AngleUnit represents a unit of angle
 type AngleUnit struct {
@@ -84,3 +87,23 @@ var Angle = struct {
 func NewAngle(value float64, unit AngleUnit) Quantity[AngleUnit] {
 	return New(value, unit)
 }
+
+// DMSString formats m as degrees, minutes and whole seconds of arc, e.g.
+// "51°30′26″" for 51.507222°. Seconds are rounded, carrying into minutes and
+// degrees; negative angles get a leading minus sign. See ParseAngleDMS.
+func DMSString(m Quantity[AngleUnit]) string {
+	seconds := math.Round(m.ConvertTo(Angle.Degree).Value * 3600)
+	sign := ""
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	degrees := math.Floor(seconds / 3600)
+	seconds -= degrees * 3600
+	minutes := math.Floor(seconds / 60)
+	seconds -= minutes * 60
+	return sign + strconv.FormatFloat(degrees, 'f', 0, 64) + "°" +
+		strconv.FormatFloat(minutes, 'f', 0, 64) + "′" +
+		strconv.FormatFloat(seconds, 'f', 0, 64) + "″"
+}
@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"errors"
 	"math"
 	"testing"
 )
@@ -135,3 +136,58 @@ func TestAngleSerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization failed: got %v, expected %v", angle2, angle)
 	}
 }
+
+func TestDMS(t *testing.T) {
+	formatTests := []struct {
+		angle    Quantity[AngleUnit]
+		expected string
+	}{
+		{NewAngle(51.507222, Angle.Degree), "51°30′26″"},
+		{NewAngle(-0.1275, Angle.Degree), "-0°7′39″"},
+		{NewAngle(10.999999, Angle.Degree), "11°0′0″"}, // rounding carries into degrees
+		{NewAngle(math.Pi/2, Angle.Radian), "90°0′0″"},
+	}
+	for _, tc := range formatTests {
+		if got := DMSString(tc.angle); got != tc.expected {
+			t.Errorf("DMSString(%v) = %q, expected %q", tc.angle, got, tc.expected)
+		}
+	}
+
+	parseTests := []struct {
+		input    string
+		expected float64
+	}{
+		{"51°30′26″", 51.507222},
+		{`51°30'26"`, 51.507222},
+		{"51° 30' 26''", 51.507222},
+		{"51°30′26″N", 51.507222},
+		{"0°7′39″W", -0.1275},
+		{"-0°7′39″", -0.1275},
+		{"45°", 45},
+		{"45°30′", 45.5},
+		{"12°34′56.5″", 12.582361},
+	}
+	for _, tc := range parseTests {
+		got, err := ParseAngleDMS(tc.input)
+		if err != nil {
+			t.Errorf("ParseAngleDMS(%q) error: %v", tc.input, err)
+			continue
+		}
+		if !got.Unit.Equals(Angle.Degree) || math.Abs(got.Value-tc.expected) > 1e-6 {
+			t.Errorf("ParseAngleDMS(%q) = %v, expected %g°", tc.input, got, tc.expected)
+		}
+	}
+
+	// Round trip through the string form
+	original := NewAngle(51.507222, Angle.Degree)
+	back, err := ParseAngleDMS(DMSString(original))
+	if err != nil || math.Abs(back.Value-original.Value) > 0.5/3600 {
+		t.Errorf("round trip of %v = %v, %v", original, back, err)
+	}
+
+	for _, input := range []string{"", "51", "51°75′", "51°30′61″", "-51°30′S", "51°30′26″X"} {
+		if _, err := ParseAngleDMS(input); !errors.Is(err, ErrMalformedValue) {
+			t.Errorf("ParseAngleDMS(%q) error = %v, expected ErrMalformedValue", input, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import "math"
+
+// AngularVelocityUnit represents a unit of angular velocity
+type AngularVelocityUnit struct {
+	BaseUnit
+}
+
+// AngularVelocity contains predefined angular velocity units
+var AngularVelocity = struct {
+	RadiansPerSecond AngularVelocityUnit
+	DegreesPerSecond AngularVelocityUnit
+}{
+	RadiansPerSecond: AngularVelocityUnit{
+		BaseUnit: NewBaseUnit(
+			"angular_velocity",
+			"rad/s",
+			"Radians per Second",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	DegreesPerSecond: AngularVelocityUnit{
+		BaseUnit: NewBaseUnit(
+			"angular_velocity",
+			"°/s",
+			"Degrees per Second",
+			0.017453292519943295, // 1 °/s = π/180 rad/s
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewAngularVelocity creates a new angular velocity quantity
+func NewAngularVelocity(value float64, unit AngularVelocityUnit) Quantity[AngularVelocityUnit] {
+	return New(value, unit)
+}
+
+// FrequencyToAngularVelocity returns the angular frequency ω = 2πf of f, in
+// rad/s
+func FrequencyToAngularVelocity(f Quantity[FrequencyUnit]) Quantity[AngularVelocityUnit] {
+	hertz := f.Unit.ConvertToBaseUnit(f.Value)
+	return NewAngularVelocity(2*math.Pi*hertz, AngularVelocity.RadiansPerSecond)
+}
+
+// AngularVelocityToFrequency returns the ordinary frequency f = ω/2π of w,
+// in Hz
+func AngularVelocityToFrequency(w Quantity[AngularVelocityUnit]) Quantity[FrequencyUnit] {
+	radiansPerSecond := w.Unit.ConvertToBaseUnit(w.Value)
+	return NewFrequency(radiansPerSecond/(2*math.Pi), Frequency.Hertz)
+}
@@ -0,0 +1,52 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAngularVelocityConversion(t *testing.T) {
+	w := NewAngularVelocity(180, AngularVelocity.DegreesPerSecond)
+	if got := w.ConvertTo(AngularVelocity.RadiansPerSecond).Value; math.Abs(got-math.Pi) > 1e-12 {
+		t.Errorf("180 °/s = %g rad/s, expected π", got)
+	}
+}
+
+func TestFrequencyAngularVelocityBridge(t *testing.T) {
+	w := FrequencyToAngularVelocity(NewFrequency(1, Frequency.Hertz))
+	if math.Abs(w.Value-2*math.Pi) > 1e-12 || !w.Unit.Equals(AngularVelocity.RadiansPerSecond) {
+		t.Errorf("1 Hz = %v, expected 2π rad/s", w)
+	}
+
+	f := AngularVelocityToFrequency(NewAngularVelocity(2*math.Pi, AngularVelocity.RadiansPerSecond))
+	if math.Abs(f.Value-1) > 1e-12 || !f.Unit.Equals(Frequency.Hertz) {
+		t.Errorf("2π rad/s = %v, expected 1 Hz", f)
+	}
+
+	// 60 rpm is one revolution per second
+	w = FrequencyToAngularVelocity(NewFrequency(60, Frequency.RPM))
+	if got := w.ConvertTo(AngularVelocity.DegreesPerSecond).Value; math.Abs(got-360) > 1e-9 {
+		t.Errorf("60 rpm = %g °/s, expected 360", got)
+	}
+	if back := AngularVelocityToFrequency(w).ConvertTo(Frequency.RPM).Value; math.Abs(back-60) > 1e-9 {
+		t.Errorf("round trip of 60 rpm = %g rpm", back)
+	}
+}
+
+func TestAngularVelocityMatchesAngle(t *testing.T) {
+	// Turning at 1 °/s for one second covers the same angle as 1°
+	perSecond := NewAngularVelocity(1, AngularVelocity.DegreesPerSecond)
+	angle := NewAngle(1, Angle.Degree)
+	if perSecond.Unit.ConvertToBaseUnit(perSecond.Value) != angle.Unit.ConvertToBaseUnit(angle.Value) {
+		t.Errorf("1 °/s and 1° have different base values in radians")
+	}
+
+	// "°/s" shares its leading degree sign with the angle and temperature units
+	q, err := ParseQuantity[AngularVelocityUnit]("30 °/s")
+	if err != nil || q.Value != 30 || !q.Unit.Equals(AngularVelocity.DegreesPerSecond) {
+		t.Errorf("ParseQuantity[AngularVelocityUnit] = %v, %v", q, err)
+	}
+	if a, err := ParseAngle("30°"); err != nil || !a.Unit.Equals(Angle.Degree) {
+		t.Errorf("ParseAngle(\"30°\") = %v, %v", a, err)
+	}
+}
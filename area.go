@@ -95,7 +95,7 @@ var Area = struct {
 			"area",
 			"mi²",
 			"Square Mile",
-			2589988.11, // 1 mi² = 2,589,988.11 m²
+			2589988.110336, // 1 mi² = 1609.344² m² = 2,589,988.110336 m² (exact)
 			0.0,
 			false,
 		),
@@ -105,7 +105,7 @@ var Area = struct {
 			"area",
 			"ac",
 			"Acre",
-			4046.86, // 1 ac = 4,046.86 m²
+			4046.8564224, // 1 ac = 1/640 mi² = 4,046.8564224 m² (exact)
 			0.0,
 			false,
 		),
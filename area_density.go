@@ -0,0 +1,52 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// AreaDensityUnit represents a unit of area density (mass per area), such as
+// paper weight (GSM) or fabric weight
+type AreaDensityUnit struct {
+	BaseUnit
+}
+
+// AreaDensity contains predefined area density units
+var AreaDensity = struct {
+	GramsPerSquareMeter     AreaDensityUnit
+	KilogramsPerSquareMeter AreaDensityUnit
+	OuncesPerSquareYard     AreaDensityUnit
+}{
+	GramsPerSquareMeter: AreaDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"area_density",
+			"g/m²",
+			"Grams per Square Meter",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	KilogramsPerSquareMeter: AreaDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"area_density",
+			"kg/m²",
+			"Kilograms per Square Meter",
+			1000.0, // 1 kg/m² = 1000 g/m²
+			0.0,
+			false,
+		),
+	},
+	OuncesPerSquareYard: AreaDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"area_density",
+			"oz/yd²",
+			"Ounces per Square Yard",
+			28.349523125/0.83612736, // 1 oz/yd² ≈ 33.906 g/m²
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewAreaDensity creates a new area density quantity
+func NewAreaDensity(value float64, unit AreaDensityUnit) Quantity[AreaDensityUnit] {
+	return New(value, unit)
+}
@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAreaDensityConversion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[AreaDensityUnit]
+		to       AreaDensityUnit
+		expected float64
+	}{
+		{"kg/m² to g/m²", NewAreaDensity(1, AreaDensity.KilogramsPerSquareMeter), AreaDensity.GramsPerSquareMeter, 1000},
+		{"oz/yd² to g/m²", NewAreaDensity(1, AreaDensity.OuncesPerSquareYard), AreaDensity.GramsPerSquareMeter, 33.905747},
+		{"copy paper", NewAreaDensity(80, AreaDensity.GramsPerSquareMeter), AreaDensity.KilogramsPerSquareMeter, 0.08},
+		{"denim", NewAreaDensity(14, AreaDensity.OuncesPerSquareYard), AreaDensity.GramsPerSquareMeter, 474.680465},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.ConvertTo(tc.to).Value; math.Abs(got-tc.expected) > 1e-6 {
+				t.Errorf("%v = %g %s, expected %g", tc.from, got, tc.to.Symbol(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestAreaDensityASCIISymbols(t *testing.T) {
+	testCases := []struct {
+		unit     AreaDensityUnit
+		expected string
+	}{
+		{AreaDensity.GramsPerSquareMeter, `"g/m2"`},
+		{AreaDensity.KilogramsPerSquareMeter, `"kg/m2"`},
+		{AreaDensity.OuncesPerSquareYard, `"oz/yd2"`},
+	}
+
+	for _, tc := range testCases {
+		data, err := MarshalWithFormatASCII(NewAreaDensity(80, tc.unit), FormatFull)
+		if err != nil || !strings.Contains(string(data), tc.expected) {
+			t.Errorf("MarshalWithFormatASCII(%s) = %s, %v", tc.unit.Symbol(), data, err)
+			continue
+		}
+		am, err := UnmarshalMeasurement(data)
+		if err != nil {
+			t.Fatalf("UnmarshalMeasurement(%s): %v", data, err)
+		}
+		if got, ok := am.AsAreaDensity(); !ok || got.Value != 80 || !got.Unit.Equals(tc.unit) {
+			t.Errorf("round trip of %s = %v, %v", data, got, ok)
+		}
+	}
+}
+
+func TestParseAreaDensity(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected AreaDensityUnit
+	}{
+		{"80 g/m²", AreaDensity.GramsPerSquareMeter},
+		{"80 g/m2", AreaDensity.GramsPerSquareMeter},
+		{"80 gsm", AreaDensity.GramsPerSquareMeter},
+		{"80 oz/yd²", AreaDensity.OuncesPerSquareYard},
+	}
+
+	for _, tc := range testCases {
+		q, err := ParseQuantity[AreaDensityUnit](tc.input)
+		if err != nil || q.Value != 80 || !q.Unit.Equals(tc.expected) {
+			t.Errorf("ParseQuantity[AreaDensityUnit](%q) = %v, %v", tc.input, q, err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAreaConversion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[AreaUnit]
+		to       AreaUnit
+		expected float64
+	}{
+		{"1 ha to m²", NewArea(1, Area.Hectare), Area.SquareMeter, 10000},
+		{"1 km² to ha", NewArea(1, Area.SquareKilometer), Area.Hectare, 100},
+		{"1 km² to m²", NewArea(1, Area.SquareKilometer), Area.SquareMeter, 1000000},
+		{"1 acre to m²", NewArea(1, Area.Acre), Area.SquareMeter, 4046.8564224},
+		{"1 acre to ha", NewArea(1, Area.Acre), Area.Hectare, 0.40468564224},
+		{"1 acre to ft²", NewArea(1, Area.Acre), Area.SquareFoot, 43560},
+		{"1 ft² to in²", NewArea(1, Area.SquareFoot), Area.SquareInch, 144},
+		{"1 yd² to ft²", NewArea(1, Area.SquareYard), Area.SquareFoot, 9},
+		{"1 m² to ft²", NewArea(1, Area.SquareMeter), Area.SquareFoot, 10.763910416709722},
+		{"1 m² to cm²", NewArea(1, Area.SquareMeter), Area.SquareCentimeter, 10000},
+		{"1 cm² to mm²", NewArea(1, Area.SquareCentimeter), Area.SquareMillimeter, 100},
+		{"640 acres to mi²", NewArea(640, Area.Acre), Area.SquareMile, 1},
+		{"1 mi² to km²", NewArea(1, Area.SquareMile), Area.SquareKilometer, 2.589988110336},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.from.ConvertTo(tc.to)
+			if math.Abs(got.Value-tc.expected) > 1e-9*math.Max(1, tc.expected) {
+				t.Errorf("%v = %v, expected %v %s", tc.from, got, tc.expected, tc.to.Symbol())
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package unit
+
+import "testing"
+
+func BenchmarkParseLength(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseLength("10.5 km"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalTemperature(b *testing.B) {
+	temp := NewTemperature(25.5, Temperature.Celsius)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := MarshalTemperature(temp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalMeasurement(b *testing.B) {
+	temp := NewTemperature(25.5, Temperature.Celsius)
+	formats := []struct {
+		name   string
+		format SerializationFormat
+	}{
+		{"Full", FormatFull},
+		{"Compact", FormatCompact},
+		{"Minimal", FormatMinimal},
+		{"CompactWithDimension", FormatCompactWithDimension},
+	}
+
+	for _, f := range formats {
+		data, err := MarshalWithFormat(temp, f.format)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(f.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := UnmarshalMeasurement(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
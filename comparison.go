@@ -0,0 +1,56 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Default tolerances used by Equal, EqualString, Between and Range.Contains
+const (
+	DefaultAbsoluteEpsilon = 1e-9
+	DefaultRelativeEpsilon = 0.0
+)
+
+// comparisonEpsilon holds the tolerances applied when comparing quantities
+var comparisonEpsilon = struct {
+	sync.RWMutex
+	abs, rel float64
+}{abs: DefaultAbsoluteEpsilon, rel: DefaultRelativeEpsilon}
+
+// SetComparisonEpsilon sets the tolerances used by Equal, EqualString,
+// Between and Range.Contains. Two base-unit values a and b are equal if
+// |a-b| < abs, or if |a-b| <= rel times the larger of |a| and |b|; bounds
+// checks count a value equal to a bound as within it. It is safe for
+// concurrent use and panics if either tolerance is negative or NaN.
+func SetComparisonEpsilon(abs, rel float64) {
+	if !(abs >= 0) || !(rel >= 0) {
+		panic(fmt.Sprintf("unit: comparison epsilon must not be negative, got abs=%g rel=%g", abs, rel))
+	}
+	comparisonEpsilon.Lock()
+	defer comparisonEpsilon.Unlock()
+	comparisonEpsilon.abs, comparisonEpsilon.rel = abs, rel
+}
+
+// ComparisonEpsilon returns the absolute and relative tolerances used by
+// Equal, EqualString, Between and Range.Contains
+func ComparisonEpsilon() (abs, rel float64) {
+	comparisonEpsilon.RLock()
+	defer comparisonEpsilon.RUnlock()
+	return comparisonEpsilon.abs, comparisonEpsilon.rel
+}
+
+// withinEpsilon reports whether a and b are equal within the configured tolerances
+func withinEpsilon(a, b float64) bool {
+	abs, rel := ComparisonEpsilon()
+	diff := math.Abs(a - b)
+	return diff < abs || diff <= rel*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// withinBounds reports whether low <= v <= high, treating v as equal to a
+// bound within the configured tolerances
+func withinBounds(v, low, high float64) bool {
+	return (v >= low || withinEpsilon(v, low)) && (v <= high || withinEpsilon(v, high))
+}
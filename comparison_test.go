@@ -0,0 +1,89 @@
+package unit
+
+import "testing"
+
+func TestSetComparisonEpsilon(t *testing.T) {
+	defer SetComparisonEpsilon(DefaultAbsoluteEpsilon, DefaultRelativeEpsilon)
+
+	if abs, rel := ComparisonEpsilon(); abs != DefaultAbsoluteEpsilon || rel != DefaultRelativeEpsilon {
+		t.Fatalf("ComparisonEpsilon() = %g, %g; expected defaults", abs, rel)
+	}
+
+	a := NewLength(1.0, Length.Meter)
+	b := NewLength(1.0001, Length.Meter)
+	if a.Equal(b) {
+		t.Error("1.0 m and 1.0001 m should differ with the default epsilon")
+	}
+
+	SetComparisonEpsilon(1e-3, 0)
+	if abs, rel := ComparisonEpsilon(); abs != 1e-3 || rel != 0 {
+		t.Errorf("ComparisonEpsilon() = %g, %g; expected 1e-3, 0", abs, rel)
+	}
+	if !a.Equal(b) {
+		t.Error("1.0 m and 1.0001 m should be equal with a loose absolute epsilon")
+	}
+	if ok, err := a.EqualString("1.0001 m"); err != nil || !ok {
+		t.Errorf("EqualString(\"1.0001 m\") = %v, %v; expected true", ok, err)
+	}
+
+	// A relative tolerance scales with the magnitude
+	SetComparisonEpsilon(0, 1e-3)
+	if !NewLength(1000, Length.Kilometer).Equal(NewLength(1000.5, Length.Kilometer)) {
+		t.Error("1000 km and 1000.5 km should be equal within 0.1 %")
+	}
+	if NewLength(1, Length.Meter).Equal(NewLength(1.01, Length.Meter)) {
+		t.Error("1 m and 1.01 m should differ by more than 0.1 %")
+	}
+
+	SetComparisonEpsilon(1e-12, 0)
+	if a.Equal(b) {
+		t.Error("1.0 m and 1.0001 m should differ with a strict epsilon")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for a negative epsilon")
+		}
+	}()
+	SetComparisonEpsilon(-1, 0)
+}
+
+func TestComparisonEpsilonAtBounds(t *testing.T) {
+	defer SetComparisonEpsilon(DefaultAbsoluteEpsilon, DefaultRelativeEpsilon)
+
+	// 0.1 + 0.2 m lands just above 0.3 m in floating point
+	sum := NewLength(0.1, Length.Meter).Add(NewLength(0.2, Length.Meter))
+	low, high := NewLength(0.2, Length.Meter), NewLength(0.3, Length.Meter)
+	r, err := NewRange(low, high)
+	if err != nil {
+		t.Fatalf("NewRange: %v", err)
+	}
+	if !sum.Between(low, high) || !r.Contains(sum) {
+		t.Errorf("%v should be within %v with the default epsilon", sum, r)
+	}
+
+	// Just outside a bound is inside only when the tolerance covers it
+	above := NewLength(300.5, Length.Millimeter)
+	below := NewLength(199.5, Length.Millimeter)
+	for _, q := range []Quantity[LengthUnit]{above, below} {
+		if q.Between(low, high) || r.Contains(q) {
+			t.Errorf("%v should be outside %v with the default epsilon", q, r)
+		}
+	}
+
+	SetComparisonEpsilon(1e-3, 0)
+	for _, q := range []Quantity[LengthUnit]{above, below} {
+		if !q.Between(low, high) || !r.Contains(q) {
+			t.Errorf("%v should be within %v with a 1 mm epsilon", q, r)
+		}
+	}
+	if q := NewLength(302, Length.Millimeter); q.Between(low, high) || r.Contains(q) {
+		t.Errorf("%v should be outside %v with a 1 mm epsilon", q, r)
+	}
+
+	// A relative tolerance scales with the bound
+	SetComparisonEpsilon(0, 0.01)
+	if q := NewLength(302, Length.Millimeter); !q.Between(low, high) || !r.Contains(q) {
+		t.Errorf("%v should be within %v with a 1 %% epsilon", q, r)
+	}
+}
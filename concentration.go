@@ -2,6 +2,10 @@
 // physical quantities with units.
 package unit
 
+// MilligramsPerLiterPerGrainPerGallon is the number of mg/L in one grain per US gallon
+// (64.79891 mg / 3.785411784 L)
+const MilligramsPerLiterPerGrainPerGallon = 64.79891 / 3.785411784
+
 // ConcentrationUnit represents a unit of concentration of mass
 type ConcentrationUnit struct {
 	BaseUnit
@@ -13,6 +17,7 @@ var Concentration = struct {
 	MilligramsPerLiter ConcentrationUnit
 	PartsPerMillion    ConcentrationUnit
 	PartsPerBillion    ConcentrationUnit
+	GrainsPerGallon    ConcentrationUnit
 }{
 	GramsPerLiter: ConcentrationUnit{
 		BaseUnit: NewBaseUnit(
@@ -54,9 +59,42 @@ var Concentration = struct {
 			false,
 		),
 	},
+	GrainsPerGallon: ConcentrationUnit{
+		BaseUnit: NewBaseUnit(
+			"concentration",
+			"gpg",
+			"Grains per Gallon",
+			MilligramsPerLiterPerGrainPerGallon/1000, // 1 gpg ≈ 0.017118 g/L (US gallon)
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewConcentration creates a new concentration measurement
 func NewConcentration(value float64, unit ConcentrationUnit) Quantity[ConcentrationUnit] {
 	return New(value, unit)
 }
+
+// GrainsPerGallonToPPM converts water hardness in grains per US gallon to ppm
+// (mg/L as CaCO₃): 1 gpg ≈ 17.118 ppm
+func GrainsPerGallonToPPM(v float64) float64 {
+	return v * MilligramsPerLiterPerGrainPerGallon
+}
+
+// AsMassFraction returns the mass fraction of solute in a solution with
+// concentration c and the given density, in ppm by mass. It assumes c is
+// mass of solute per volume of the whole solution and density is that of the
+// solution, so fraction = c / ρ; for dilute aqueous solutions ρ ≈ 1000 kg/m³
+// and 1 g/L ≈ 1000 ppm. Note that the "ppm" concentration unit already
+// assumes water and is first converted to g/L. It panics if density is not
+// positive.
+func AsMassFraction(c Quantity[ConcentrationUnit], density Quantity[DensityUnit]) Quantity[DispersionUnit] {
+	// kg/m³ equals g/L, so both sides are in the same unit
+	rho := density.Unit.ConvertToBaseUnit(density.Value)
+	if rho <= 0 {
+		panic("unit: solution density must be positive")
+	}
+	gramsPerLiter := c.Unit.ConvertToBaseUnit(c.Value)
+	return NewDispersion(gramsPerLiter/rho*1e6, Dispersion.PartsPerMillion)
+}
@@ -135,3 +135,72 @@ func TestConcentrationSerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization failed: got %v, expected %v", conc2, conc)
 	}
 }
+
+func TestGrainsPerGallon(t *testing.T) {
+	// Expected: 1 gpg ≈ 17.12 ppm
+	if ppm := GrainsPerGallonToPPM(1); math.Abs(ppm-17.12) > 0.005 {
+		t.Errorf("GrainsPerGallonToPPM(1) = %g, expected ≈17.12", ppm)
+	}
+
+	// The unit agrees with the helper
+	ppm := NewConcentration(1, Concentration.GrainsPerGallon).ConvertTo(Concentration.PartsPerMillion)
+	if math.Abs(ppm.Value-GrainsPerGallonToPPM(1)) > 1e-9 {
+		t.Errorf("1 gpg = %g ppm, expected %g ppm", ppm.Value, GrainsPerGallonToPPM(1))
+	}
+
+	conc, err := ParseConcentration("5 gpg")
+	if err != nil {
+		t.Fatalf("Failed to parse concentration: %v", err)
+	}
+	if conc.Value != 5 || !conc.Unit.Equals(Concentration.GrainsPerGallon) {
+		t.Errorf("Parsed concentration incorrect: got %v, expected 5 gpg", conc)
+	}
+
+	// Expected: 5 gpg ≈ 85.59 mg/L
+	mgL := conc.ConvertTo(Concentration.MilligramsPerLiter)
+	if math.Abs(mgL.Value-85.59) > 0.01 {
+		t.Errorf("5 gpg = %g mg/L, expected ≈85.59 mg/L", mgL.Value)
+	}
+
+	data, err := MarshalConcentration(conc)
+	if err != nil {
+		t.Fatalf("Failed to marshal concentration: %v", err)
+	}
+	conc2, err := UnmarshalConcentration(data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal concentration: %v", err)
+	}
+	if !conc2.Unit.Equals(Concentration.GrainsPerGallon) || conc2.Value != 5 {
+		t.Errorf("Round-trip serialization failed: got %v, expected %v", conc2, conc)
+	}
+}
+
+func TestAsMassFraction(t *testing.T) {
+	water := NewDensity(1000, Density.KilogramsPerCubicMeter)
+	testCases := []struct {
+		name    string
+		c       Quantity[ConcentrationUnit]
+		density Quantity[DensityUnit]
+		ppm     float64
+	}{
+		{"1 g/L in water", NewConcentration(1, Concentration.GramsPerLiter), water, 1000},
+		{"mg/L in water", NewConcentration(250, Concentration.MilligramsPerLiter), water, 250},
+		{"density in g/cm³", NewConcentration(1, Concentration.GramsPerLiter), NewDensity(1, Density.GramsPerCubicCentimeter), 1000},
+		{"brine", NewConcentration(35, Concentration.GramsPerLiter), NewDensity(1025, Density.KilogramsPerCubicMeter), 35.0 / 1025 * 1e6},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := AsMassFraction(tc.c, tc.density)
+			if !got.Unit.Equals(Dispersion.PartsPerMillion) || math.Abs(got.Value-tc.ppm) > 1e-9 {
+				t.Errorf("AsMassFraction = %v, expected %g ppm", got, tc.ppm)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for zero density")
+		}
+	}()
+	AsMassFraction(NewConcentration(1, Concentration.GramsPerLiter), NewDensity(0, Density.KilogramsPerCubicMeter))
+}
@@ -0,0 +1,16 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// Constants contains reference physical constants as typed quantities
+var Constants = struct {
+	SpeedOfLight       Quantity[SpeedUnit]        // c, exact by SI definition
+	StandardGravity    Quantity[AccelerationUnit] // gₙ, exact by CGPM definition
+	StandardAtmosphere Quantity[PressureUnit]     // atm, exact by ISO 2533 definition
+	AbsoluteZero       Quantity[TemperatureUnit]  // 0 K
+}{
+	SpeedOfLight:       NewSpeed(299792458, Speed.MetersPerSecond),
+	StandardGravity:    NewAcceleration(9.80665, Acceleration.MetersPerSecondSquared),
+	StandardAtmosphere: NewPressure(101325, Pressure.Pascal),
+	AbsoluteZero:       NewTemperature(0, Temperature.Kelvin),
+}
@@ -0,0 +1,38 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConstants(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quantity Quantity[Category]
+		expected float64 // In base units
+	}{
+		{"SpeedOfLight", Constants.SpeedOfLight.erased(), 299792458},
+		{"StandardGravity", Constants.StandardGravity.erased(), 9.80665},
+		{"StandardAtmosphere", Constants.StandardAtmosphere.erased(), 101325},
+		{"AbsoluteZero", Constants.AbsoluteZero.erased(), -273.15}, // Base unit is °C
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.quantity.Unit.ConvertToBaseUnit(tc.quantity.Value)
+			if got != tc.expected {
+				t.Errorf("%s = %v in base units, expected %v", tc.name, got, tc.expected)
+			}
+		})
+	}
+
+	if c := Constants.AbsoluteZero.ConvertTo(Temperature.Celsius); math.Abs(c.Value+273.15) > 1e-9 {
+		t.Errorf("AbsoluteZero = %v, expected -273.15 °C", c)
+	}
+	if g := Constants.StandardGravity.ConvertTo(Acceleration.G); math.Abs(g.Value-1) > 1e-12 {
+		t.Errorf("StandardGravity = %v, expected 1 g", g)
+	}
+	if kpa := Constants.StandardAtmosphere.ConvertTo(Pressure.Kilopascal); math.Abs(kpa.Value-101.325) > 1e-9 {
+		t.Errorf("StandardAtmosphere = %v, expected 101.325 kPa", kpa)
+	}
+}
@@ -3,16 +3,41 @@
 package unit
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 )
 
+// Kinds of parse and unmarshal failures, for use with errors.Is
+var (
+	// ErrUnknownUnit reports a unit symbol, name, or key that is not registered
+	ErrUnknownUnit = errors.New("unknown unit")
+	// ErrMalformedValue reports input whose number or format cannot be read
+	ErrMalformedValue = errors.New("malformed value")
+)
+
+// kindError is an error message tagged with its kind of failure
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e kindError) Error() string { return e.msg }
+func (e kindError) Unwrap() error { return e.kind }
+
+// kindErrorf formats an error message like fmt.Errorf and tags it with kind,
+// leaving the message itself unchanged
+func kindErrorf(kind error, format string, args ...any) error {
+	return kindError{kind: kind, msg: fmt.Sprintf(format, args...)}
+}
+
 // ParseError represents an error that occurred during parsing a measurement string
 type ParseError struct {
 	Input string
 	Msg   string
+	Err   error // Kind of failure, ErrUnknownUnit or ErrMalformedValue
 }
 
 // Error returns the error message
@@ -20,8 +45,16 @@ func (e ParseError) Error() string {
 	return fmt.Sprintf("failed to parse measurement '%s': %s", e.Input, e.Msg)
 }
 
-// Regular expression to match a measurement string like "22.5°C" or "101.3 kPa"
-var measurementRegex = regexp.MustCompile(`^([-+]?\d*\.?\d+)\s*([^\d\s].*)$`)
+// Unwrap returns the kind of failure, so that errors.Is(err, ErrUnknownUnit)
+// and errors.Is(err, ErrMalformedValue) work on parse errors
+func (e ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Regular expression to match a measurement string like "22.5°C" or "101.3 kPa".
+// The space is optional ("1.5kWh", "30min"); a trailing decimal point belongs
+// to the number, so "5.km" is 5 km rather than 5 of the unit ".km".
+var measurementRegex = regexp.MustCompile(`^([-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?)\s*([^\d\s].*)$`)
 
 // ParseTemperature parses a string like "22.5°C" into a Temperature measurement
 func ParseTemperature(s string) (Quantity[TemperatureUnit], error) {
@@ -30,26 +63,12 @@ func ParseTemperature(s string) (Quantity[TemperatureUnit], error) {
 		return Quantity[TemperatureUnit]{}, err
 	}
 
-	// Find the matching temperature unit
-	var unit TemperatureUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "c", "°c", "celsius":
-		unit = Temperature.Celsius
-		found = true
-	case "f", "°f", "fahrenheit":
-		unit = Temperature.Fahrenheit
-		found = true
-	case "k", "kelvin":
-		unit = Temperature.Kelvin
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[TemperatureUnit]("temperature", unitStr)
+	if !ok {
 		return Quantity[TemperatureUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown temperature unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -63,46 +82,36 @@ func ParsePressure(s string) (Quantity[PressureUnit], error) {
 		return Quantity[PressureUnit]{}, err
 	}
 
-	// Find the matching pressure unit
-	var unit PressureUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "pa", "pascal":
-		unit = Pressure.Pascal
-		found = true
-	case "kpa", "kilopascal":
-		unit = Pressure.Kilopascal
-		found = true
-	case "bar":
-		unit = Pressure.Bar
-		found = true
-	case "psi":
-		unit = Pressure.PSI
-		found = true
-	case "inh2o", "inh₂o", "inch water":
-		unit = Pressure.InchH2O
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[PressureUnit]("pressure", unitStr)
+	if !ok {
 		return Quantity[PressureUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown pressure unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
 	return NewPressure(value, unit), nil
 }
 
+// minusNormalizer maps Unicode dash and minus variants to an ASCII hyphen-minus
+// so that values like "−40 °C" (U+2212) parse like "-40 °C"
+var minusNormalizer = strings.NewReplacer(
+	"\u2212", "-", // minus sign
+	"\u2012", "-", // figure dash
+	"\u2010", "-", // hyphen
+	"\u2011", "-", // non-breaking hyphen
+)
+
 // Helper function to parse a string into a value and unit string
 func parseValueAndUnit(s string) (float64, string, error) {
-	s = strings.TrimSpace(s)
+	s = minusNormalizer.Replace(strings.TrimSpace(s))
 	matches := measurementRegex.FindStringSubmatch(s)
 	if matches == nil {
 		return 0, "", ParseError{
 			Input: s,
 			Msg:   "invalid format, expected '<value><unit>' (e.g., '22.5°C')",
+			Err:   ErrMalformedValue,
 		}
 	}
 
@@ -114,12 +123,107 @@ func parseValueAndUnit(s string) (float64, string, error) {
 		return 0, "", ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("invalid number: %s", valueStr),
+			Err:   ErrMalformedValue,
 		}
 	}
 
 	return value, unitStr, nil
 }
 
+// NewFromStrings creates a quantity from a separate value and unit string,
+// e.g. NewFromStrings[LengthUnit]("5", "km"). The unit is resolved from the
+// registry of T's dimension by symbol first, then by name.
+func NewFromStrings[T Category](valueStr, unitStr string) (Quantity[T], error) {
+	input := valueStr + " " + unitStr
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(valueStr), 64)
+	if err != nil {
+		return Quantity[T]{}, ParseError{
+			Input: input,
+			Msg:   fmt.Sprintf("invalid number: %s", valueStr),
+			Err:   ErrMalformedValue,
+		}
+	}
+
+	dimension := dimensionOf[T]()
+	unitStr = strings.TrimSpace(unitStr)
+
+	unit, err := lookupUnit[T](dimension, unitStr)
+	if err != nil {
+		unit, err = lookupUnitByName[T](dimension, toSnakeCase(unitStr))
+	}
+	if err != nil {
+		return Quantity[T]{}, ParseError{
+			Input: input,
+			Msg:   fmt.Sprintf("unknown %s unit: %s", dimension, unitStr),
+			Err:   ErrUnknownUnit,
+		}
+	}
+
+	return New(value, unit), nil
+}
+
+// ParseQuantity parses a string like "10.5 m" into a quantity of unit type T.
+// Dimensions with a dedicated parser (e.g. ParseLength) use it so that their
+// aliases are accepted; other dimensions resolve the unit from the registry.
+func ParseQuantity[T Category](s string) (Quantity[T], error) {
+	var zero T
+	var q any
+	var err error
+
+	switch any(zero).(type) {
+	case TemperatureUnit:
+		q, err = ParseTemperature(s)
+	case PressureUnit:
+		q, err = ParsePressure(s)
+	case LengthUnit:
+		q, err = ParseLength(s)
+	case MassUnit:
+		q, err = ParseMass(s)
+	case DurationUnit:
+		q, err = ParseDuration(s)
+	case AngleUnit:
+		q, err = ParseAngle(s)
+	case AreaUnit:
+		q, err = ParseArea(s)
+	case VolumeUnit:
+		q, err = ParseVolume(s)
+	case AccelerationUnit:
+		q, err = ParseAcceleration(s)
+	case ConcentrationUnit:
+		q, err = ParseConcentration(s)
+	case DispersionUnit:
+		q, err = ParseDispersion(s)
+	case ElectricChargeUnit:
+		q, err = ParseElectricCharge(s)
+	case ElectricCurrentUnit:
+		q, err = ParseElectricCurrent(s)
+	case SpeedUnit:
+		q, err = ParseSpeed(s)
+	case ElectricPotentialDifferenceUnit:
+		q, err = ParseElectricPotentialDifference(s)
+	case InformationUnit:
+		q, err = ParseInformation(s)
+	case MagneticFluxDensityUnit:
+		q, err = ParseMagneticFluxDensity(s)
+	case RatioUnit:
+		q, err = ParseRatio(s)
+	case GeneralUnit:
+		q, err = parseGeneral(s)
+	default:
+		value, unitStr, perr := parseValueAndUnit(s)
+		if perr != nil {
+			return Quantity[T]{}, perr
+		}
+		return NewFromStrings[T](strconv.FormatFloat(value, 'g', -1, 64), unitStr)
+	}
+
+	if err != nil {
+		return Quantity[T]{}, err
+	}
+	return q.(Quantity[T]), nil
+}
+
 // ParseLength parses a string like "10.5 m" into a Length measurement
 func ParseLength(s string) (Quantity[LengthUnit], error) {
 	value, unitStr, err := parseValueAndUnit(s)
@@ -127,47 +231,12 @@ func ParseLength(s string) (Quantity[LengthUnit], error) {
 		return Quantity[LengthUnit]{}, err
 	}
 
-	// Find the matching length unit
-	var unit LengthUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "m", "meter", "meters":
-		unit = Length.Meter
-		found = true
-	case "km", "kilometer", "kilometers":
-		unit = Length.Kilometer
-		found = true
-	case "cm", "centimeter", "centimeters":
-		unit = Length.Centimeter
-		found = true
-	case "mm", "millimeter", "millimeters":
-		unit = Length.Millimeter
-		found = true
-	case "µm", "um", "micrometer", "micrometers":
-		unit = Length.Micrometer
-		found = true
-	case "nm", "nanometer", "nanometers":
-		unit = Length.Nanometer
-		found = true
-	case "in", "inch", "inches":
-		unit = Length.Inch
-		found = true
-	case "ft", "foot", "feet":
-		unit = Length.Foot
-		found = true
-	case "yd", "yard", "yards":
-		unit = Length.Yard
-		found = true
-	case "mi", "mile", "miles":
-		unit = Length.Mile
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[LengthUnit]("length", unitStr)
+	if !ok {
 		return Quantity[LengthUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown length unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -181,50 +250,47 @@ func ParseMass(s string) (Quantity[MassUnit], error) {
 		return Quantity[MassUnit]{}, err
 	}
 
-	// Find the matching mass unit
-	var unit MassUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "kg", "kilogram", "kilograms":
-		unit = Mass.Kilogram
-		found = true
-	case "g", "gram", "grams":
-		unit = Mass.Gram
-		found = true
-	case "mg", "milligram", "milligrams":
-		unit = Mass.Milligram
-		found = true
-	case "µg", "ug", "microgram", "micrograms":
-		unit = Mass.Microgram
-		found = true
-	case "lb", "pound", "pounds":
-		unit = Mass.Pound
-		found = true
-	case "oz", "ounce", "ounces":
-		unit = Mass.Ounce
-		found = true
-	case "st", "stone", "stones":
-		unit = Mass.Stone
-		found = true
-	case "t", "metric ton", "metric tons":
-		unit = Mass.MetricTon
-		found = true
-	case "ton", "tons", "short ton", "short tons":
-		unit = Mass.Ton
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[MassUnit]("mass", unitStr)
+	if !ok {
 		return Quantity[MassUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown mass unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
 	return NewMass(value, unit), nil
 }
 
+// stonePoundsRegex matches a UK body-weight string like "11 st 4 lb", with
+// either part optional
+var stonePoundsRegex = regexp.MustCompile(`(?i)^(?:(\d+(?:\.\d+)?)\s*(?:st|stones?))?\s*(?:(\d+(?:\.\d+)?)\s*(?:lbs?|pounds?))?$`)
+
+// ParseMassStonePounds parses a composite weight like "11 st 4 lb", "11st",
+// or "4.5 lb" into a Mass measurement in kilograms
+func ParseMassStonePounds(s string) (Quantity[MassUnit], error) {
+	s = strings.TrimSpace(s)
+	matches := stonePoundsRegex.FindStringSubmatch(s)
+	if matches == nil || (matches[1] == "" && matches[2] == "") {
+		return Quantity[MassUnit]{}, ParseError{
+			Input: s,
+			Msg:   "invalid format, expected '<stone> st <pounds> lb' (e.g., '11 st 4 lb')",
+			Err:   ErrMalformedValue,
+		}
+	}
+
+	var kg float64
+	if matches[1] != "" {
+		stones, _ := strconv.ParseFloat(matches[1], 64) // digits only, checked by the regex
+		kg += Mass.Stone.ConvertToBaseUnit(stones)
+	}
+	if matches[2] != "" {
+		pounds, _ := strconv.ParseFloat(matches[2], 64)
+		kg += Mass.Pound.ConvertToBaseUnit(pounds)
+	}
+	return NewMass(kg, Mass.Kilogram), nil
+}
+
 // ParseDuration parses a string like "30 min" into a Duration measurement
 func ParseDuration(s string) (Quantity[DurationUnit], error) {
 	value, unitStr, err := parseValueAndUnit(s)
@@ -232,38 +298,12 @@ func ParseDuration(s string) (Quantity[DurationUnit], error) {
 		return Quantity[DurationUnit]{}, err
 	}
 
-	// Find the matching duration unit
-	var unit DurationUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "s", "sec", "second", "seconds":
-		unit = Duration.Second
-		found = true
-	case "min", "minute", "minutes":
-		unit = Duration.Minute
-		found = true
-	case "h", "hr", "hour", "hours":
-		unit = Duration.Hour
-		found = true
-	case "d", "day", "days":
-		unit = Duration.Day
-		found = true
-	case "ms", "millisecond", "milliseconds":
-		unit = Duration.Millisecond
-		found = true
-	case "µs", "us", "microsecond", "microseconds":
-		unit = Duration.Microsecond
-		found = true
-	case "ns", "nanosecond", "nanoseconds":
-		unit = Duration.Nanosecond
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[DurationUnit]("duration", unitStr)
+	if !ok {
 		return Quantity[DurationUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown duration unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -277,41 +317,69 @@ func ParseAngle(s string) (Quantity[AngleUnit], error) {
 		return Quantity[AngleUnit]{}, err
 	}
 
-	// Find the matching angle unit
-	var unit AngleUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "rad", "radian", "radians":
-		unit = Angle.Radian
-		found = true
-	case "°", "deg", "degree", "degrees":
-		unit = Angle.Degree
-		found = true
-	case "′", "arcmin", "arcminute", "arcminutes":
-		unit = Angle.Arcminute
-		found = true
-	case "″", "arcsec", "arcsecond", "arcseconds":
-		unit = Angle.Arcsecond
-		found = true
-	case "rev", "revolution", "revolutions":
-		unit = Angle.Revolution
-		found = true
-	case "grad", "gradian", "gradians":
-		unit = Angle.Gradian
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[AngleUnit]("angle", unitStr)
+	if !ok {
 		return Quantity[AngleUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown angle unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
 	return NewAngle(value, unit), nil
 }
 
+// dmsRegex matches a degrees-minutes-seconds angle like 51°30′26″ or
+// 51°30'26", with optional minutes, seconds and N/S/E/W hemisphere
+var dmsRegex = regexp.MustCompile(`(?i)^([-+]?)(\d+(?:\.\d+)?)\s*°\s*(?:(\d+(?:\.\d+)?)\s*['′]\s*)?(?:(\d+(?:\.\d+)?)\s*(?:"|″|'')\s*)?([NSEW]?)$`)
+
+// ParseAngleDMS parses a degrees-minutes-seconds string like "51°30′26″" or
+// "0°7'39\"W" into an Angle measurement in decimal degrees. Both Unicode
+// primes (′ ″) and ASCII apostrophe and quote are accepted; a leading minus
+// sign or an S or W hemisphere makes the angle negative.
+func ParseAngleDMS(s string) (Quantity[AngleUnit], error) {
+	s = minusNormalizer.Replace(strings.TrimSpace(s))
+	matches := dmsRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return Quantity[AngleUnit]{}, ParseError{
+			Input: s,
+			Msg:   "invalid format, expected degrees-minutes-seconds (e.g., '51°30′26″')",
+			Err:   ErrMalformedValue,
+		}
+	}
+
+	// The groups are digits only, checked by the regex
+	degrees, _ := strconv.ParseFloat(matches[2], 64)
+	var minutes, seconds float64
+	if matches[3] != "" {
+		minutes, _ = strconv.ParseFloat(matches[3], 64)
+	}
+	if matches[4] != "" {
+		seconds, _ = strconv.ParseFloat(matches[4], 64)
+	}
+	if minutes >= 60 || seconds >= 60 {
+		return Quantity[AngleUnit]{}, ParseError{
+			Input: s,
+			Msg:   "minutes and seconds must be less than 60",
+			Err:   ErrMalformedValue,
+		}
+	}
+
+	value := degrees + minutes/60 + seconds/3600
+	hemisphere := strings.ToUpper(matches[5])
+	if matches[1] == "-" && hemisphere != "" {
+		return Quantity[AngleUnit]{}, ParseError{
+			Input: s,
+			Msg:   "use either a minus sign or a hemisphere, not both",
+			Err:   ErrMalformedValue,
+		}
+	}
+	if matches[1] == "-" || hemisphere == "S" || hemisphere == "W" {
+		value = -value
+	}
+	return NewAngle(value, Angle.Degree), nil
+}
+
 // ParseArea parses a string like "100 m²" into an Area measurement
 func ParseArea(s string) (Quantity[AreaUnit], error) {
 	value, unitStr, err := parseValueAndUnit(s)
@@ -319,47 +387,12 @@ func ParseArea(s string) (Quantity[AreaUnit], error) {
 		return Quantity[AreaUnit]{}, err
 	}
 
-	// Find the matching area unit
-	var unit AreaUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "m²", "m2", "sq m", "square meter", "square meters":
-		unit = Area.SquareMeter
-		found = true
-	case "km²", "km2", "sq km", "square kilometer", "square kilometers":
-		unit = Area.SquareKilometer
-		found = true
-	case "cm²", "cm2", "sq cm", "square centimeter", "square centimeters":
-		unit = Area.SquareCentimeter
-		found = true
-	case "mm²", "mm2", "sq mm", "square millimeter", "square millimeters":
-		unit = Area.SquareMillimeter
-		found = true
-	case "in²", "in2", "sq in", "square inch", "square inches":
-		unit = Area.SquareInch
-		found = true
-	case "ft²", "ft2", "sq ft", "square foot", "square feet":
-		unit = Area.SquareFoot
-		found = true
-	case "yd²", "yd2", "sq yd", "square yard", "square yards":
-		unit = Area.SquareYard
-		found = true
-	case "mi²", "mi2", "sq mi", "square mile", "square miles":
-		unit = Area.SquareMile
-		found = true
-	case "ac", "acre", "acres":
-		unit = Area.Acre
-		found = true
-	case "ha", "hectare", "hectares":
-		unit = Area.Hectare
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[AreaUnit]("area", unitStr)
+	if !ok {
 		return Quantity[AreaUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown area unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -373,59 +406,12 @@ func ParseVolume(s string) (Quantity[VolumeUnit], error) {
 		return Quantity[VolumeUnit]{}, err
 	}
 
-	// Find the matching volume unit
-	var unit VolumeUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "m³", "m3", "cu m", "cubic meter", "cubic meters":
-		unit = Volume.CubicMeter
-		found = true
-	case "km³", "km3", "cu km", "cubic kilometer", "cubic kilometers":
-		unit = Volume.CubicKilometer
-		found = true
-	case "cm³", "cm3", "cc", "cu cm", "cubic centimeter", "cubic centimeters":
-		unit = Volume.CubicCentimeter
-		found = true
-	case "mm³", "mm3", "cu mm", "cubic millimeter", "cubic millimeters":
-		unit = Volume.CubicMillimeter
-		found = true
-	case "l", "liter", "liters", "litre", "litres":
-		unit = Volume.Liter
-		found = true
-	case "ml", "milliliter", "milliliters", "millilitre", "millilitres":
-		unit = Volume.Milliliter
-		found = true
-	case "in³", "in3", "cu in", "cubic inch", "cubic inches":
-		unit = Volume.CubicInch
-		found = true
-	case "ft³", "ft3", "cu ft", "cubic foot", "cubic feet":
-		unit = Volume.CubicFoot
-		found = true
-	case "yd³", "yd3", "cu yd", "cubic yard", "cubic yards":
-		unit = Volume.CubicYard
-		found = true
-	case "gal", "gallon", "gallons":
-		unit = Volume.Gallon
-		found = true
-	case "qt", "quart", "quarts":
-		unit = Volume.Quart
-		found = true
-	case "pt", "pint", "pints":
-		unit = Volume.Pint
-		found = true
-	case "cup", "cups":
-		unit = Volume.Cup
-		found = true
-	case "fl oz", "fluid ounce", "fluid ounces":
-		unit = Volume.FluidOunce
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[VolumeUnit]("volume", unitStr)
+	if !ok {
 		return Quantity[VolumeUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown volume unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -439,26 +425,12 @@ func ParseAcceleration(s string) (Quantity[AccelerationUnit], error) {
 		return Quantity[AccelerationUnit]{}, err
 	}
 
-	// Find the matching acceleration unit
-	var unit AccelerationUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "m/s²", "m/s2", "meters per second squared":
-		unit = Acceleration.MetersPerSecondSquared
-		found = true
-	case "g", "g-force":
-		unit = Acceleration.G
-		found = true
-	case "ft/s²", "ft/s2", "feet per second squared":
-		unit = Acceleration.FeetPerSecondSquared
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[AccelerationUnit]("acceleration", unitStr)
+	if !ok {
 		return Quantity[AccelerationUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown acceleration unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -472,29 +444,12 @@ func ParseConcentration(s string) (Quantity[ConcentrationUnit], error) {
 		return Quantity[ConcentrationUnit]{}, err
 	}
 
-	// Find the matching concentration unit
-	var unit ConcentrationUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "g/l", "grams per liter":
-		unit = Concentration.GramsPerLiter
-		found = true
-	case "mg/l", "milligrams per liter":
-		unit = Concentration.MilligramsPerLiter
-		found = true
-	case "ppm", "parts per million":
-		unit = Concentration.PartsPerMillion
-		found = true
-	case "ppb", "parts per billion":
-		unit = Concentration.PartsPerBillion
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[ConcentrationUnit]("concentration", unitStr)
+	if !ok {
 		return Quantity[ConcentrationUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown concentration unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -507,36 +462,80 @@ func ParseDispersion(s string) (Quantity[DispersionUnit], error) {
 	if err != nil {
 		return Quantity[DispersionUnit]{}, err
 	}
-
-	// Find the matching dispersion unit
-	var unit DispersionUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "ppm", "parts per million":
-		unit = Dispersion.PartsPerMillion
-		found = true
-	case "ppb", "parts per billion":
-		unit = Dispersion.PartsPerBillion
-		found = true
-	case "ppt", "parts per trillion":
-		unit = Dispersion.PartsPerTrillion
-		found = true
-	case "%", "percent":
-		unit = Dispersion.Percent
-		found = true
+	if parts, ok := percentParts(unitStr); ok {
+		return NewDispersion(value*(100/parts), Dispersion.Percent), nil
 	}
 
-	if !found {
+	unit, ok := parserUnit[DispersionUnit]("dispersion", unitStr)
+	if !ok {
 		return Quantity[DispersionUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown dispersion unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
 	return NewDispersion(value, unit), nil
 }
 
+// percentScales maps percent and per-mille spellings to the number of parts
+// they divide a whole into
+var percentScales = map[string]float64{
+	"%":         100,
+	"percent":   100,
+	"‰":         1000,
+	"per mille": 1000,
+	"permille":  1000,
+}
+
+// percentParts returns the number of parts per whole of a percent or
+// per-mille unit string, e.g. 100 for "%"
+func percentParts(unitStr string) (float64, bool) {
+	parts, ok := percentScales[strings.ToLower(strings.TrimSpace(unitStr))]
+	return parts, ok
+}
+
+// ParsePercent parses a percentage or per-mille string like "50 %", "50%", or
+// "5 ‰" into its fractional value (0.5, 0.5, and 0.005). A bare number is
+// rejected, since "0.5" could mean either 0.5 or 0.5 %.
+func ParsePercent(s string) (float64, error) {
+	if _, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+		return 0, ParseError{
+			Input: s,
+			Msg:   "ambiguous bare number, expected a '%' or '‰' sign",
+			Err:   ErrUnknownUnit,
+		}
+	}
+
+	value, unitStr, err := parseValueAndUnit(s)
+	if err != nil {
+		return 0, err
+	}
+	parts, ok := percentParts(unitStr)
+	if !ok {
+		return 0, ParseError{
+			Input: s,
+			Msg:   fmt.Sprintf("unknown percent unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
+		}
+	}
+	return value / parts, nil
+}
+
+// parseGeneral parses a string like "50 %" into a General measurement,
+// resolving percent and per-mille to General.Percent and any other unit to
+// a custom general unit
+func parseGeneral(s string) (Quantity[GeneralUnit], error) {
+	value, unitStr, err := parseValueAndUnit(s)
+	if err != nil {
+		return Quantity[GeneralUnit]{}, err
+	}
+	if parts, ok := percentParts(unitStr); ok {
+		return NewGeneral(value*(100/parts), General.Percent), nil
+	}
+	return NewGeneral(value, NewGeneralUnit(unitStr, unitStr)), nil
+}
+
 // ParseElectricCharge parses a string like "5 C" into an ElectricCharge measurement
 func ParseElectricCharge(s string) (Quantity[ElectricChargeUnit], error) {
 	value, unitStr, err := parseValueAndUnit(s)
@@ -544,32 +543,12 @@ func ParseElectricCharge(s string) (Quantity[ElectricChargeUnit], error) {
 		return Quantity[ElectricChargeUnit]{}, err
 	}
 
-	// Find the matching electric charge unit
-	var unit ElectricChargeUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "c", "coulomb", "coulombs":
-		unit = ElectricCharge.Coulomb
-		found = true
-	case "mc", "millicoulomb", "millicoulombs":
-		unit = ElectricCharge.Millicoulomb
-		found = true
-	case "µc", "uc", "microcoulomb", "microcoulombs":
-		unit = ElectricCharge.Microcoulomb
-		found = true
-	case "ah", "ampere-hour", "ampere-hours":
-		unit = ElectricCharge.Ampere_Hour
-		found = true
-	case "mah", "milliampere-hour", "milliampere-hours":
-		unit = ElectricCharge.Milliampere_Hour
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[ElectricChargeUnit]("electric_charge", unitStr)
+	if !ok {
 		return Quantity[ElectricChargeUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown electric charge unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -583,35 +562,38 @@ func ParseElectricCurrent(s string) (Quantity[ElectricCurrentUnit], error) {
 		return Quantity[ElectricCurrentUnit]{}, err
 	}
 
-	// Find the matching electric current unit
-	var unit ElectricCurrentUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "a", "ampere", "amperes":
-		unit = ElectricCurrent.Ampere
-		found = true
-	case "ma", "milliampere", "milliamperes":
-		unit = ElectricCurrent.Milliampere
-		found = true
-	case "µa", "ua", "microampere", "microamperes":
-		unit = ElectricCurrent.Microampere
-		found = true
-	case "ka", "kiloampere", "kiloamperes":
-		unit = ElectricCurrent.Kiloampere
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[ElectricCurrentUnit]("electric_current", unitStr)
+	if !ok {
 		return Quantity[ElectricCurrentUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown electric current unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
 	return NewElectricCurrent(value, unit), nil
 }
 
+// ParseMagneticFluxDensity parses a string like "50 µT" or "0.5 G" into a
+// MagneticFluxDensity measurement
+func ParseMagneticFluxDensity(s string) (Quantity[MagneticFluxDensityUnit], error) {
+	value, unitStr, err := parseValueAndUnit(s)
+	if err != nil {
+		return Quantity[MagneticFluxDensityUnit]{}, err
+	}
+
+	unit, ok := parserUnit[MagneticFluxDensityUnit]("magnetic_flux_density", unitStr)
+	if !ok {
+		return Quantity[MagneticFluxDensityUnit]{}, ParseError{
+			Input: s,
+			Msg:   fmt.Sprintf("unknown magnetic flux density unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
+		}
+	}
+
+	return NewMagneticFluxDensity(value, unit), nil
+}
+
 // ParseSpeed parses a string like "10 m/s" into a Speed measurement
 func ParseSpeed(s string) (Quantity[SpeedUnit], error) {
 	value, unitStr, err := parseValueAndUnit(s)
@@ -619,32 +601,12 @@ func ParseSpeed(s string) (Quantity[SpeedUnit], error) {
 		return Quantity[SpeedUnit]{}, err
 	}
 
-	// Find the matching speed unit
-	var unit SpeedUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "m/s", "meters per second", "metres per second":
-		unit = Speed.MetersPerSecond
-		found = true
-	case "km/h", "kilometers per hour", "kilometres per hour", "kph":
-		unit = Speed.KilometersPerHour
-		found = true
-	case "mph", "miles per hour":
-		unit = Speed.MilesPerHour
-		found = true
-	case "ft/s", "feet per second", "foot per second":
-		unit = Speed.FeetPerSecond
-		found = true
-	case "kn", "knot", "knots":
-		unit = Speed.Knot
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[SpeedUnit]("speed", unitStr)
+	if !ok {
 		return Quantity[SpeedUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown speed unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
@@ -658,38 +620,77 @@ func ParseElectricPotentialDifference(s string) (Quantity[ElectricPotentialDiffe
 		return Quantity[ElectricPotentialDifferenceUnit]{}, err
 	}
 
-	// Find the matching electric potential difference unit
-	var unit ElectricPotentialDifferenceUnit
-	found := false
-
-	switch strings.ToLower(unitStr) {
-	case "v", "volt", "volts":
-		unit = ElectricPotentialDifference.Volt
-		found = true
-	case "mv", "millivolt", "millivolts":
-		unit = ElectricPotentialDifference.Millivolt
-		found = true
-	case "µv", "uv", "microvolt", "microvolts":
-		unit = ElectricPotentialDifference.Microvolt
-		found = true
-	case "kv", "kilovolt", "kilovolts":
-		unit = ElectricPotentialDifference.Kilovolt
-		found = true
-	case "megav", "megavolt", "megavolts":
-		unit = ElectricPotentialDifference.Megavolt
-		found = true
-	}
-
-	if !found {
+	unit, ok := parserUnit[ElectricPotentialDifferenceUnit]("electric_potential_difference", unitStr)
+	if !ok {
 		return Quantity[ElectricPotentialDifferenceUnit]{}, ParseError{
 			Input: s,
 			Msg:   fmt.Sprintf("unknown electric potential difference unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
 		}
 	}
 
 	return NewElectricPotentialDifference(value, unit), nil
 }
 
+// ParseInformation parses a string like "4 MiB" into an Information measurement.
+// Byte and bit units are told apart by their suffix, so "Kibit" is a kibibit
+// while "KiB" is a kibibyte. Abbreviations ending in a bare b/B are matched
+// case-sensitively: "B" and "MB" are bytes, "b" is a bit and "Mb" is rejected
+// rather than read as a megabyte.
+func ParseInformation(s string) (Quantity[InformationUnit], error) {
+	value, unitStr, err := parseValueAndUnit(s)
+	if err != nil {
+		return Quantity[InformationUnit]{}, err
+	}
+
+	unit, ok := parserUnit[InformationUnit]("information", unitStr)
+	if !ok {
+		return Quantity[InformationUnit]{}, ParseError{
+			Input: s,
+			Msg:   fmt.Sprintf("unknown information unit: %s", unitStr),
+			Err:   ErrUnknownUnit,
+		}
+	}
+
+	return NewInformation(value, unit), nil
+}
+
+// ParseRatio parses a ratio written as "a:b", e.g. "16:9" or "3.73:1", into
+// a Ratio measurement holding a/b. A bare number such as "1.5" is taken as
+// already divided.
+func ParseRatio(s string) (Quantity[RatioUnit], error) {
+	num, den, found := strings.Cut(strings.TrimSpace(s), ":")
+	a, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+	if err != nil {
+		return Quantity[RatioUnit]{}, ParseError{
+			Input: s,
+			Msg:   "invalid format, expected '<a>:<b>' (e.g., '16:9')",
+			Err:   ErrMalformedValue,
+		}
+	}
+	if !found {
+		return NewRatio(a, Ratio.Dimensionless), nil
+	}
+
+	b, err := strconv.ParseFloat(strings.TrimSpace(den), 64)
+	if err != nil {
+		return Quantity[RatioUnit]{}, ParseError{
+			Input: s,
+			Msg:   "invalid format, expected '<a>:<b>' (e.g., '16:9')",
+			Err:   ErrMalformedValue,
+		}
+	}
+	if b == 0 {
+		return Quantity[RatioUnit]{}, ParseError{
+			Input: s,
+			Msg:   "ratio denominator must not be zero",
+			Err:   ErrMalformedValue,
+		}
+	}
+
+	return NewRatio(a/b, Ratio.Dimensionless), nil
+}
+
 // FormatWithUnit formats a value with its unit symbol
 func FormatWithUnit(value float64, unitSymbol string) string {
 	return fmt.Sprintf("%g %s", value, unitSymbol)
@@ -0,0 +1,71 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// conversionKey identifies a conversion between two units of a dimension.
+// Units are identified by dimension and symbol, consistent with BaseUnit.Equals.
+type conversionKey struct {
+	dimension string
+	from      string
+	to        string
+}
+
+// conversionFactors is the composed linear transform of a conversion
+// (target = value*scale + offset) between two registered units
+type conversionFactors struct {
+	from   Category
+	to     Category
+	scale  float64
+	offset float64
+}
+
+// conversionTable holds the composed transforms between every pair of linear
+// units in the registries. The registries do not change after initialization,
+// so the table is built once and read without locking.
+var conversionTable = buildConversionTable()
+
+// buildConversionTable composes the transforms for all registered unit pairs,
+// leaving out units that are not linear (e.g. L/100km)
+func buildConversionTable() map[conversionKey]conversionFactors {
+	table := make(map[conversionKey]conversionFactors)
+	for dimension := range registries {
+		units := registeredUnits(dimension)
+		for _, from := range units {
+			fromScale, fromOffset, ok := unitFactors(from)
+			if !ok {
+				continue
+			}
+			for _, to := range units {
+				toScale, toOffset, ok := unitFactors(to)
+				if !ok {
+					continue
+				}
+
+				// base = value*fromScale + fromOffset; target = (base - toOffset) / toScale
+				key := conversionKey{dimension: dimension, from: from.Symbol(), to: to.Symbol()}
+				table[key] = conversionFactors{
+					from:   from,
+					to:     to,
+					scale:  fromScale / toScale,
+					offset: (fromOffset - toOffset) / toScale,
+				}
+			}
+		}
+	}
+	return table
+}
+
+// cachedConversion returns the composed transform from one unit to another.
+// ok is false unless both units are the registered units themselves: a type
+// defined elsewhere may embed BaseUnit under a registered symbol and override
+// ConvertToBaseUnit, so it must be converted via the base unit instead. Units
+// of the general dimension are never registered, so they are not cached.
+func cachedConversion[T Category](from, to T) (conversionFactors, bool) {
+	key := conversionKey{dimension: from.Dimension(), from: from.Symbol(), to: to.Symbol()}
+	f, ok := conversionTable[key]
+	// Registered units are comparable, so these comparisons cannot panic
+	if !ok || f.from != Category(from) || f.to != Category(to) {
+		return conversionFactors{}, false
+	}
+	return f, true
+}
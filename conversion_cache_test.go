@@ -0,0 +1,121 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCachedConversionMatchesUncached(t *testing.T) {
+	values := []float64{-40, 0, 1, 25.5, 1e6}
+
+	for _, dim := range ListDimensions() {
+		units := registeredUnits(dim)
+		for _, from := range units {
+			for _, to := range units {
+				for _, v := range values {
+					if dim == "fuel_efficiency" && v == 0 {
+						continue // L/100km is undefined at zero
+					}
+					q := Quantity[Category]{Value: v, Unit: from}
+					cached := q.ConvertTo(to)
+					uncached := q.convertViaBase(to)
+
+					tol := 1e-9 * math.Max(1, math.Abs(uncached.Value))
+					if math.Abs(cached.Value-uncached.Value) > tol {
+						t.Errorf("%s: %g %s -> %s: cached %g, uncached %g",
+							dim, v, from.Symbol(), to.Symbol(), cached.Value, uncached.Value)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCachedConversionAffine(t *testing.T) {
+	// Expected: 0°C = 273.15K, both on first use and from the cache
+	for i := 0; i < 2; i++ {
+		k := NewTemperature(0, Temperature.Celsius).ConvertTo(Temperature.Kelvin)
+		if math.Abs(k.Value-273.15) > 1e-9 {
+			t.Errorf("Pass %d: got %gK, expected 273.15K", i, k.Value)
+		}
+	}
+
+	// Expected: 300K = 26.85°C
+	c := NewTemperature(300, Temperature.Kelvin).ConvertTo(Temperature.Celsius)
+	if math.Abs(c.Value-26.85) > 1e-9 {
+		t.Errorf("Got %g°C, expected 26.85°C", c.Value)
+	}
+}
+
+func TestCachedConversionSkipsGeneral(t *testing.T) {
+	// Two general units sharing a symbol must not share a cached transform
+	a := NewGeneralUnitWithConversion("x", "x", 2, 0)
+	b := NewGeneralUnitWithConversion("y", "y", 1, 0)
+	c := NewGeneralUnitWithConversion("x", "x", 10, 0)
+
+	if got := NewGeneral(1, a).ConvertTo(b).Value; got != 2 {
+		t.Errorf("Got %g, expected 2", got)
+	}
+	if got := NewGeneral(1, c).ConvertTo(b).Value; got != 10 {
+		t.Errorf("Got %g, expected 10", got)
+	}
+}
+
+func BenchmarkConvertTo(b *testing.B) {
+	q := NewTemperature(25, Temperature.Celsius)
+	for i := 0; i < b.N; i++ {
+		_ = q.ConvertTo(Temperature.Kelvin)
+	}
+}
+
+// BenchmarkConvertToUncached mirrors ConvertTo without the conversion cache
+func BenchmarkConvertToUncached(b *testing.B) {
+	q := NewTemperature(25, Temperature.Celsius)
+	for i := 0; i < b.N; i++ {
+		if !q.Unit.Equals(Temperature.Kelvin) && q.Unit.Dimension() == Temperature.Kelvin.Dimension() {
+			_ = q.convertViaBase(Temperature.Kelvin)
+		}
+	}
+}
+
+func TestConvertToDoesNotAllocate(t *testing.T) {
+	temp := NewTemperature(25, Temperature.Celsius)
+	length := NewLength(1, Length.Kilometer)
+	temp.ConvertTo(Temperature.Kelvin) // Warm the cache
+	length.ConvertTo(Length.Mile)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = temp.ConvertTo(Temperature.Kelvin)
+		_ = length.ConvertTo(Length.Mile)
+	})
+	if allocs != 0 {
+		t.Errorf("ConvertTo allocated %v times per run, expected 0", allocs)
+	}
+}
+
+// shiftedMeter embeds a registered unit but overrides its conversion, as a
+// type defined outside the package could
+type shiftedMeter struct {
+	LengthUnit
+}
+
+func (u shiftedMeter) ConvertToBaseUnit(value float64) float64 {
+	return value + 1
+}
+
+func TestCachedConversionSkipsOverriddenUnits(t *testing.T) {
+	// Same dimension and symbol as Length.Meter, so only the unit comparison
+	// keeps it off the composed transform
+	q := Quantity[Category]{Value: 1, Unit: shiftedMeter{Length.Meter}}
+	if got := q.ConvertTo(Length.Kilometer).Value; math.Abs(got-0.002) > 1e-12 {
+		t.Errorf("Got %g km, expected 0.002 km from the overridden conversion", got)
+	}
+
+	// L/100km is the reciprocal of km/L and has no composed transform
+	if _, ok := cachedConversion(FuelEfficiency.LitersPer100Kilometers, FuelEfficiency.KilometersPerLiter); ok {
+		t.Error("Expected no composed transform for L/100km")
+	}
+	if got := NewFuelEfficiency(5, FuelEfficiency.LitersPer100Kilometers).ConvertTo(FuelEfficiency.KilometersPerLiter).Value; got != 20 {
+		t.Errorf("5 L/100km = %g km/L, expected 20", got)
+	}
+}
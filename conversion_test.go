@@ -0,0 +1,120 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorKinds(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  func() error
+		kind error
+	}{
+		{"unknown unit", func() error { _, err := ParseLength("5 xyz"); return err }, ErrUnknownUnit},
+		{"malformed value", func() error { _, err := ParseLength("abc m"); return err }, ErrMalformedValue},
+		{"generic unknown unit", func() error { _, err := ParseQuantity[FrequencyUnit]("5 xyz"); return err }, ErrUnknownUnit},
+		{"from strings malformed", func() error { _, err := NewFromStrings[LengthUnit]("abc", "m"); return err }, ErrMalformedValue},
+		{"ratio malformed", func() error { _, err := ParseRatio("16:x"); return err }, ErrMalformedValue},
+		{"unmarshal unknown unit", func() error {
+			_, err := UnmarshalLength([]byte(`{"value":5,"unit":{"symbol":"xyz","name":"Xyz","dimension":"length"}}`))
+			return err
+		}, ErrUnknownUnit},
+		{"unmarshal malformed value", func() error {
+			_, err := UnmarshalLength([]byte(`{"value":"abc","unit":"length_meter"}`))
+			return err
+		}, ErrMalformedValue},
+		{"compact unknown unit", func() error { _, err := UnmarshalCompactLength([]byte(`{"value":5,"unit":"length_xyz"}`)); return err }, ErrUnknownUnit},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.err()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !errors.Is(err, tc.kind) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tc.kind)
+			}
+			other := ErrMalformedValue
+			if tc.kind == ErrMalformedValue {
+				other = ErrUnknownUnit
+			}
+			if errors.Is(err, other) {
+				t.Errorf("errors.Is(%v, %v) = true, expected false", err, other)
+			}
+		})
+	}
+
+	// The kinds are carried by ParseError, so errors.As still finds it
+	_, err := ParseLength("5 xyz")
+	var pe ParseError
+	if !errors.As(err, &pe) || pe.Input != "5 xyz" {
+		t.Errorf("errors.As(%v, *ParseError) failed", err)
+	}
+}
+
+func TestParseWithoutSpace(t *testing.T) {
+	parsers := map[string]func(string) (float64, string, error){
+		"length": func(s string) (float64, string, error) {
+			q, err := ParseLength(s)
+			return q.Value, q.Unit.Symbol(), err
+		},
+		"duration": func(s string) (float64, string, error) {
+			q, err := ParseDuration(s)
+			return q.Value, q.Unit.Symbol(), err
+		},
+		"energy": func(s string) (float64, string, error) {
+			q, err := ParseQuantity[EnergyUnit](s)
+			return q.Value, q.Unit.Symbol(), err
+		},
+		"power": func(s string) (float64, string, error) {
+			q, err := ParseQuantity[PowerUnit](s)
+			return q.Value, q.Unit.Symbol(), err
+		},
+	}
+
+	testCases := []struct {
+		dimension string
+		input     string
+		value     float64
+		symbol    string
+	}{
+		{"length", "100km", 100, "km"},
+		{"length", "100m", 100, "m"},
+		{"length", "100mi", 100, "mi"},
+		{"length", "250mm", 250, "mm"},
+		{"length", ".5km", 0.5, "km"},
+		{"length", "5.km", 5, "km"},
+		{"length", "-12ft", -12, "ft"},
+		{"length", "1e3m", 1000, "m"},
+		{"duration", "30min", 30, "min"},
+		{"duration", "10ms", 10, "ms"},
+		{"duration", "1.5h", 1.5, "h"},
+		{"duration", "45s", 45, "s"},
+		{"energy", "1.5kWh", 1.5, "kWh"},
+		{"energy", "100J", 100, "J"},
+		{"energy", "3.2BTU", 3.2, "BTU"},
+		{"power", "100kW", 100, "kW"},
+		{"power", "750W", 750, "W"},
+		{"power", "12000BTU/h", 12000, "BTU/h"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.dimension+"/"+tc.input, func(t *testing.T) {
+			value, symbol, err := parsers[tc.dimension](tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if value != tc.value || symbol != tc.symbol {
+				t.Errorf("got %v %s, expected %v %s", value, symbol, tc.value, tc.symbol)
+			}
+		})
+	}
+
+	// A prefix alone is not a unit: "100k" must not be read as 100 km
+	for _, input := range []string{"100k", "1.5kW", "30min"} {
+		if _, err := ParseLength(input); !errors.Is(err, ErrUnknownUnit) {
+			t.Errorf("ParseLength(%q) error = %v, expected ErrUnknownUnit", input, err)
+		}
+	}
+}
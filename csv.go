@@ -0,0 +1,70 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ReadCSVColumn reads measurements of the given dimension from CSV records
+// (as returned by encoding/csv's ReadAll) with the value in column valueCol and
+// the unit in column unitCol. Units are resolved by symbol or name from the
+// dimension's registry; for "general" any unit string is accepted.
+// Errors report the zero-based row index.
+func ReadCSVColumn(records [][]string, valueCol, unitCol int, dimension string) ([]*AnyMeasurement, error) {
+	if valueCol < 0 || unitCol < 0 {
+		return nil, fmt.Errorf("invalid column index: value %d, unit %d", valueCol, unitCol)
+	}
+	r, known := registries[dimension]
+	if !known && dimension != "general" {
+		return nil, kindErrorf(ErrUnknownUnit, "unknown dimension: %s", dimension)
+	}
+
+	measurements := make([]*AnyMeasurement, 0, len(records))
+	for i, record := range records {
+		if valueCol >= len(record) || unitCol >= len(record) {
+			return nil, fmt.Errorf("row %d: expected at least %d columns, got %d",
+				i, max(valueCol, unitCol)+1, len(record))
+		}
+
+		valueStr := minusNormalizer.Replace(strings.TrimSpace(record[valueCol]))
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			return nil, kindErrorf(ErrMalformedValue, "row %d: invalid number: %s", i, record[valueCol])
+		}
+
+		unitStr := strings.TrimSpace(record[unitCol])
+		if !known {
+			unit := NewGeneralUnit(unitStr, unitStr)
+			measurements = append(measurements, &AnyMeasurement{value: NewGeneral(value, unit), dimension: dimension})
+			continue
+		}
+
+		unit, ok := r.resolve(unitStr)
+		if !ok {
+			return nil, kindErrorf(ErrUnknownUnit, "row %d: unknown %s unit: %s", i, dimension, unitStr)
+		}
+		measurements = append(measurements, &AnyMeasurement{value: r.quantity(value, unit), dimension: dimension})
+	}
+	return measurements, nil
+}
+
+// WriteCSVColumn converts measurements into CSV records (for encoding/csv's
+// WriteAll) with value, unit symbol, and dimension columns
+func WriteCSVColumn(measurements []*AnyMeasurement) ([][]string, error) {
+	records := make([][]string, 0, len(measurements))
+	for i, am := range measurements {
+		q, ok := am.quantity()
+		if !ok {
+			return nil, fmt.Errorf("row %d: measurement holds no quantity", i)
+		}
+		records = append(records, []string{
+			strconv.FormatFloat(q.Value, 'g', -1, 64),
+			q.Unit.Symbol(),
+			am.GetDimension(),
+		})
+	}
+	return records, nil
+}
@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	input := "sensor,distance,unit\n" +
+		"a,10.5,m\n" +
+		"b,2,km\n" +
+		"c,-3,Foot\n"
+
+	records, err := csv.NewReader(strings.NewReader(input)).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read CSV: %v", err)
+	}
+
+	measurements, err := ReadCSVColumn(records[1:], 1, 2, "length")
+	if err != nil {
+		t.Fatalf("ReadCSVColumn failed: %v", err)
+	}
+
+	expected := []Quantity[LengthUnit]{
+		NewLength(10.5, Length.Meter),
+		NewLength(2, Length.Kilometer),
+		NewLength(-3, Length.Foot),
+	}
+	if len(measurements) != len(expected) {
+		t.Fatalf("Expected %d measurements, got %d", len(expected), len(measurements))
+	}
+	for i, am := range measurements {
+		length, ok := am.AsLength()
+		if !ok || length.Value != expected[i].Value || !length.Unit.Equals(expected[i].Unit) {
+			t.Errorf("Row %d: got %v, expected %v", i, am.value, expected[i])
+		}
+	}
+
+	out, err := WriteCSVColumn(measurements)
+	if err != nil {
+		t.Fatalf("WriteCSVColumn failed: %v", err)
+	}
+	var sb strings.Builder
+	if err := csv.NewWriter(&sb).WriteAll(out); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+	expectedCSV := "10.5,m,length\n2,km,length\n-3,ft,length\n"
+	if sb.String() != expectedCSV {
+		t.Errorf("Expected CSV:\n%s\ngot:\n%s", expectedCSV, sb.String())
+	}
+
+	// Written records read back to the same measurements
+	again, err := ReadCSVColumn(out, 0, 1, "length")
+	if err != nil {
+		t.Fatalf("ReadCSVColumn failed on written records: %v", err)
+	}
+	for i, am := range again {
+		length, _ := am.AsLength()
+		if !length.Equal(expected[i]) {
+			t.Errorf("Row %d: got %v, expected %v", i, length, expected[i])
+		}
+	}
+}
+
+func TestReadCSVColumnErrors(t *testing.T) {
+	records := [][]string{
+		{"1", "m"},
+		{"2", "parsec"},
+	}
+	_, err := ReadCSVColumn(records, 0, 1, "length")
+	if err == nil || !strings.Contains(err.Error(), "row 1") {
+		t.Errorf("Expected row-indexed error for bad unit, got %v", err)
+	}
+	if !errors.Is(err, ErrUnknownUnit) || errors.Is(err, ErrMalformedValue) {
+		t.Errorf("Expected ErrUnknownUnit for bad unit, got %v", err)
+	}
+
+	_, err = ReadCSVColumn([][]string{{"abc", "m"}}, 0, 1, "length")
+	if err == nil || !strings.Contains(err.Error(), "row 0") {
+		t.Errorf("Expected row-indexed error for bad value, got %v", err)
+	}
+	if !errors.Is(err, ErrMalformedValue) {
+		t.Errorf("Expected ErrMalformedValue for bad value, got %v", err)
+	}
+
+	_, err = ReadCSVColumn([][]string{{"1"}}, 0, 1, "length")
+	if err == nil {
+		t.Error("Expected error for missing column")
+	}
+
+	for _, cols := range [][2]int{{-1, 1}, {0, -1}} {
+		if _, err := ReadCSVColumn(records, cols[0], cols[1], "length"); err == nil {
+			t.Errorf("Expected error for negative column in %v", cols)
+		}
+	}
+
+	_, err = ReadCSVColumn(records, 0, 1, "unknown_dimension")
+	if !errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("Expected ErrUnknownUnit for unknown dimension, got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// DensityUnit represents a unit of mass density
+type DensityUnit struct {
+	BaseUnit
+}
+
+// Density contains predefined density units
+var Density = struct {
+	KilogramsPerCubicMeter  DensityUnit
+	GramsPerCubicCentimeter DensityUnit
+	PoundsPerCubicFoot      DensityUnit
+}{
+	KilogramsPerCubicMeter: DensityUnit{
+		BaseUnit: NewBaseUnit(
+			"density",
+			"kg/m³",
+			"Kilograms per Cubic Meter",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	GramsPerCubicCentimeter: DensityUnit{
+		BaseUnit: NewBaseUnit(
+			"density",
+			"g/cm³",
+			"Grams per Cubic Centimeter",
+			1000.0, // 1 g/cm³ = 1000 kg/m³
+			0.0,
+			false,
+		),
+	},
+	PoundsPerCubicFoot: DensityUnit{
+		BaseUnit: NewBaseUnit(
+			"density",
+			"lb/ft³",
+			"Pounds per Cubic Foot",
+			0.45359237/0.028316846592, // 1 lb/ft³ ≈ 16.0185 kg/m³
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewDensity creates a new density quantity
+func NewDensity(value float64, unit DensityUnit) Quantity[DensityUnit] {
+	return New(value, unit)
+}
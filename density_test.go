@@ -0,0 +1,43 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDensityConversion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[DensityUnit]
+		to       DensityUnit
+		expected float64
+	}{
+		{"water", NewDensity(1, Density.GramsPerCubicCentimeter), Density.KilogramsPerCubicMeter, 1000},
+		{"water in lb/ft³", NewDensity(1, Density.GramsPerCubicCentimeter), Density.PoundsPerCubicFoot, 62.42796},
+		{"steel", NewDensity(7.85, Density.GramsPerCubicCentimeter), Density.KilogramsPerCubicMeter, 7850},
+		{"air", NewDensity(1.225, Density.KilogramsPerCubicMeter), Density.PoundsPerCubicFoot, 0.0764743},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.ConvertTo(tc.to).Value; math.Abs(got-tc.expected) > 1e-6*tc.expected {
+				t.Errorf("%v = %g %s, expected %g", tc.from, got, tc.to.Symbol(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestDensityBaseMatchesConcentration(t *testing.T) {
+	// AsMassFraction divides the two base values directly, which relies on
+	// kg/m³ and g/L being the same quantity
+	rho := NewDensity(1, Density.KilogramsPerCubicMeter)
+	c := NewConcentration(1, Concentration.GramsPerLiter)
+	if rho.Unit.ConvertToBaseUnit(rho.Value) != c.Unit.ConvertToBaseUnit(c.Value) {
+		t.Errorf("1 kg/m³ and 1 g/L have different base values")
+	}
+
+	q, err := ParseQuantity[DensityUnit]("7.85 g/cm³")
+	if err != nil || q.Value != 7.85 || !q.Unit.Equals(Density.GramsPerCubicCentimeter) {
+		t.Errorf("ParseQuantity[DensityUnit] = %v, %v", q, err)
+	}
+}
@@ -60,3 +60,13 @@ var Dispersion = struct {
 func NewDispersion(value float64, unit DispersionUnit) Quantity[DispersionUnit] {
 	return New(value, unit)
 }
+
+// PortionOf returns the part of a full-scale quantity that the dispersion d
+// represents, e.g. 10 % of 200 m = 20 m. The dispersion is taken as a fraction
+// of one: percent / 100, ppm / 10⁶, ppb / 10⁹, ppt / 10¹². The result keeps
+// the unit of full, like MultiplyByScalar.
+func PortionOf[T Category](d Quantity[DispersionUnit], full Quantity[T]) Quantity[T] {
+	// The base unit is ppm, so the fraction is the base value / 10⁶
+	fraction := d.Unit.ConvertToBaseUnit(d.Value) / 1e6
+	return full.MultiplyByScalar(fraction)
+}
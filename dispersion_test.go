@@ -135,3 +135,76 @@ func TestDispersionSerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization failed: got %v, expected %v", disp2, disp)
 	}
 }
+
+func TestPortionOf(t *testing.T) {
+	// Expected: 10 % of 200 m = 20 m
+	length := PortionOf(NewDispersion(10, Dispersion.Percent), NewLength(200, Length.Meter))
+	if math.Abs(length.Value-20) > 1e-9 || !length.Unit.Equals(Length.Meter) {
+		t.Errorf("10 %% of 200 m: got %v, expected 20 m", length)
+	}
+
+	// Expected: 500 ppm of 2 kg = 0.001 kg
+	mass := PortionOf(NewDispersion(500, Dispersion.PartsPerMillion), NewMass(2, Mass.Kilogram))
+	if math.Abs(mass.Value-0.001) > 1e-12 || !mass.Unit.Equals(Mass.Kilogram) {
+		t.Errorf("500 ppm of 2 kg: got %v, expected 0.001 kg", mass)
+	}
+
+	// Expected: 250 ppb of 4 L = 1e-6 L
+	volume := PortionOf(NewDispersion(250, Dispersion.PartsPerBillion), NewVolume(4, Volume.Liter))
+	if math.Abs(volume.Value-1e-6) > 1e-15 {
+		t.Errorf("250 ppb of 4 L: got %v, expected 1e-06 L", volume)
+	}
+}
+
+func TestParsePercent(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected float64
+	}{
+		{"50 %", 0.5},
+		{"50%", 0.5},
+		{"12.5 percent", 0.125},
+		{"5 ‰", 0.005},
+		{"5‰", 0.005},
+		{"-10 %", -0.1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParsePercent(tc.input)
+			if err != nil {
+				t.Fatalf("ParsePercent(%q): %v", tc.input, err)
+			}
+			if math.Abs(got-tc.expected) > 1e-12 {
+				t.Errorf("ParsePercent(%q) = %v, expected %v", tc.input, got, tc.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"0.5", "50", "50 ppm", "half"} {
+		if _, err := ParsePercent(input); err == nil {
+			t.Errorf("ParsePercent(%q): expected error", input)
+		}
+	}
+}
+
+func TestPercentSharedAcrossDimensions(t *testing.T) {
+	d, err := ParseDispersion("5 ‰")
+	if err != nil {
+		t.Fatalf("ParseDispersion: %v", err)
+	}
+	if math.Abs(d.Value-0.5) > 1e-12 || !d.Unit.Equals(Dispersion.Percent) {
+		t.Errorf("ParseDispersion(\"5 ‰\") = %v, expected 0.5 %%", d)
+	}
+
+	g, err := ParseQuantity[GeneralUnit]("50%")
+	if err != nil {
+		t.Fatalf("ParseQuantity[GeneralUnit]: %v", err)
+	}
+	if g.Value != 50 || !g.Unit.Equals(General.Percent) {
+		t.Errorf("ParseQuantity[GeneralUnit](\"50%%\") = %v, expected 50 %%", g)
+	}
+	if base := g.Unit.ConvertToBaseUnit(g.Value); math.Abs(base-0.5) > 1e-12 {
+		t.Errorf("50 %% in base general units = %v, expected 0.5", base)
+	}
+}
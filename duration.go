@@ -2,6 +2,8 @@
 // physical quantities with units.
 package unit
 
+import "math"
+
 // DurationUnit represents a unit of time duration
 type DurationUnit struct {
 	BaseUnit
@@ -93,3 +95,30 @@ var Duration = struct {
 func NewDuration(value float64, unit DurationUnit) Quantity[DurationUnit] {
 	return New(value, unit)
 }
+
+// BestDurationUnit returns m converted to the largest of ns, µs, ms, s, min,
+// h, and d in which its magnitude is at least 1, e.g. 90000 ms → 1.5 min and
+// 0.0005 s → 500 µs. Zero and non-finite durations are returned in seconds.
+// BestUnit chooses among the same units in every unit system.
+func BestDurationUnit(m Quantity[DurationUnit]) Quantity[DurationUnit] {
+	units := []DurationUnit{
+		Duration.Day,
+		Duration.Hour,
+		Duration.Minute,
+		Duration.Second,
+		Duration.Millisecond,
+		Duration.Microsecond,
+		Duration.Nanosecond,
+	}
+
+	seconds := m.Unit.ConvertToBaseUnit(m.Value)
+	if seconds == 0 || math.IsInf(seconds, 0) || math.IsNaN(seconds) {
+		return m.ConvertTo(Duration.Second)
+	}
+	for _, u := range units {
+		if math.Abs(u.ConvertFromBaseUnit(seconds)) >= 1 {
+			return m.ConvertTo(u)
+		}
+	}
+	return m.ConvertTo(Duration.Nanosecond)
+}
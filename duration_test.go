@@ -135,3 +135,34 @@ func TestDurationSerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization failed: got %v, expected %v", duration2, duration)
 	}
 }
+
+func TestBestDurationUnit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Quantity[DurationUnit]
+		value    float64
+		expected DurationUnit
+	}{
+		{"sub-microsecond", NewDuration(0.0000000005, Duration.Second), 0.5, Duration.Nanosecond},
+		{"microseconds", NewDuration(0.0005, Duration.Second), 500, Duration.Microsecond},
+		{"milliseconds", NewDuration(0.25, Duration.Second), 250, Duration.Millisecond},
+		{"seconds", NewDuration(45000, Duration.Millisecond), 45, Duration.Second},
+		{"minutes", NewDuration(90000, Duration.Millisecond), 1.5, Duration.Minute},
+		{"hours", NewDuration(7200, Duration.Second), 2, Duration.Hour},
+		{"multi-day", NewDuration(60, Duration.Hour), 2.5, Duration.Day},
+		{"negative", NewDuration(-90, Duration.Second), -1.5, Duration.Minute},
+		{"zero", NewDuration(0, Duration.Hour), 0, Duration.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := BestDurationUnit(tc.input)
+			if !got.Unit.Equals(tc.expected) {
+				t.Errorf("BestDurationUnit(%v) unit = %s, expected %s", tc.input, got.Unit.Symbol(), tc.expected.Symbol())
+			}
+			if math.Abs(got.Value-tc.value) > 1e-9*math.Max(1, math.Abs(tc.value)) {
+				t.Errorf("BestDurationUnit(%v) value = %v, expected %v", tc.input, got.Value, tc.value)
+			}
+		})
+	}
+}
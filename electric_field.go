@@ -0,0 +1,51 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// ElectricFieldUnit represents a unit of electric field strength
+type ElectricFieldUnit struct {
+	BaseUnit
+}
+
+// ElectricField contains predefined electric field units
+var ElectricField = struct {
+	VoltsPerMeter      ElectricFieldUnit
+	KilovoltsPerMeter  ElectricFieldUnit
+	VoltsPerCentimeter ElectricFieldUnit
+}{
+	VoltsPerMeter: ElectricFieldUnit{
+		BaseUnit: NewBaseUnit(
+			"electric_field",
+			"V/m",
+			"Volts per Meter",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	KilovoltsPerMeter: ElectricFieldUnit{
+		BaseUnit: NewBaseUnit(
+			"electric_field",
+			"kV/m",
+			"Kilovolts per Meter",
+			1000.0,
+			0.0,
+			false,
+		),
+	},
+	VoltsPerCentimeter: ElectricFieldUnit{
+		BaseUnit: NewBaseUnit(
+			"electric_field",
+			"V/cm",
+			"Volts per Centimeter",
+			100.0,
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewElectricField creates a new electric field quantity
+func NewElectricField(value float64, unit ElectricFieldUnit) Quantity[ElectricFieldUnit] {
+	return New(value, unit)
+}
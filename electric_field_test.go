@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestElectricFieldConversion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[ElectricFieldUnit]
+		to       ElectricFieldUnit
+		expected float64
+	}{
+		{"kV/m to V/m", NewElectricField(1, ElectricField.KilovoltsPerMeter), ElectricField.VoltsPerMeter, 1000},
+		{"V/cm to V/m", NewElectricField(1, ElectricField.VoltsPerCentimeter), ElectricField.VoltsPerMeter, 100},
+		{"V/cm to kV/m", NewElectricField(10, ElectricField.VoltsPerCentimeter), ElectricField.KilovoltsPerMeter, 1},
+		// Dielectric strength of dry air, about 30 kV/cm
+		{"air breakdown", NewElectricField(3000, ElectricField.KilovoltsPerMeter), ElectricField.VoltsPerCentimeter, 30000},
+		// Fair-weather field near the ground
+		{"fair weather", NewElectricField(1.3, ElectricField.VoltsPerCentimeter), ElectricField.VoltsPerMeter, 130},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.ConvertTo(tc.to).Value; math.Abs(got-tc.expected) > 1e-9*tc.expected {
+				t.Errorf("%v = %g %s, expected %g", tc.from, got, tc.to.Symbol(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestElectricFieldParsingBesideVoltage(t *testing.T) {
+	// Field symbols start with the volt symbol; each must resolve in its own dimension
+	testCases := []struct {
+		input    string
+		expected ElectricFieldUnit
+	}{
+		{"1.2 kV/m", ElectricField.KilovoltsPerMeter},
+		{"1.2 V/m", ElectricField.VoltsPerMeter},
+		{"1.2 V/cm", ElectricField.VoltsPerCentimeter},
+	}
+
+	for _, tc := range testCases {
+		q, err := ParseQuantity[ElectricFieldUnit](tc.input)
+		if err != nil || q.Value != 1.2 || !q.Unit.Equals(tc.expected) {
+			t.Errorf("ParseQuantity[ElectricFieldUnit](%q) = %v, %v", tc.input, q, err)
+		}
+	}
+
+	if v, err := ParseElectricPotentialDifference("1.2 kV"); err != nil || v.Unit.Dimension() != "electric_potential_difference" {
+		t.Errorf("ParseElectricPotentialDifference(\"1.2 kV\") = %v, %v", v, err)
+	}
+	if _, err := ParseQuantity[ElectricFieldUnit]("1.2 kV"); err == nil {
+		t.Error("expected an error for a voltage without a length")
+	}
+}
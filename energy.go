@@ -12,6 +12,7 @@ var Energy = struct {
 	Joule        EnergyUnit
 	KilowattHour EnergyUnit
 	BTU          EnergyUnit
+	TonTNT       EnergyUnit
 }{
 	Joule: EnergyUnit{
 		BaseUnit: NewBaseUnit(
@@ -43,6 +44,16 @@ var Energy = struct {
 			false,
 		),
 	},
+	TonTNT: EnergyUnit{
+		BaseUnit: NewBaseUnit(
+			"energy",
+			"tTNT",
+			"Ton of TNT",
+			4.184e9, // 1 tTNT = 4.184 GJ (by convention)
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewEnergy creates a new energy quantity
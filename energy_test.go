@@ -0,0 +1,27 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTonTNT(t *testing.T) {
+	blast := NewEnergy(1, Energy.TonTNT)
+	if got := blast.ConvertTo(Energy.Joule).Value; got != 4.184e9 {
+		t.Errorf("1 tTNT = %g J, expected 4.184e9", got)
+	}
+	if got := blast.ConvertTo(Energy.KilowattHour).Value; math.Abs(got-1162.222) > 0.001 {
+		t.Errorf("1 tTNT = %g kWh, expected ≈1162.222", got)
+	}
+
+	for _, format := range []SerializationFormat{FormatFull, FormatCompact, FormatMinimal} {
+		data, err := MarshalWithFormat(NewEnergy(15, Energy.TonTNT), format)
+		if err != nil {
+			t.Fatalf("MarshalWithFormat(%v) error: %v", format, err)
+		}
+		got, err := UnmarshalEnergy(data)
+		if err != nil || got.Value != 15 || !got.Unit.Equals(Energy.TonTNT) {
+			t.Errorf("UnmarshalEnergy(%s) = %v, %v", data, got, err)
+		}
+	}
+}
@@ -38,7 +38,7 @@ var FlowRate = struct {
 			"flowrate",
 			"CFM",
 			"Cubic Feet per Minute",
-			1.699, // 1 CFM = 1.699 m³/h
+			1.69901079552, // 1 CFM = 0.028316846592 m³ * 60 = 1.69901079552 m³/h
 			0.0,
 			false,
 		),
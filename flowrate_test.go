@@ -0,0 +1,75 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFlowRateConversion(t *testing.T) {
+	// Create a flow rate in cubic meters per hour
+	flowM3h := NewFlowRate(36.0, FlowRate.CubicMetersPerHour)
+
+	// Convert to liters per second
+	flowLs := flowM3h.ConvertTo(FlowRate.LitersPerSecond)
+
+	// Expected: 36 m³/h = 10 L/s
+	expected := 10.0
+	if math.Abs(flowLs.Value-expected) > 0.0001 {
+		t.Errorf("Flow rate conversion failed: got %g L/s, expected %g L/s", flowLs.Value, expected)
+	}
+
+	// Convert to cubic feet per minute
+	flowCFM := NewFlowRate(1.0, FlowRate.CFM).ConvertTo(FlowRate.LitersPerSecond)
+
+	// Expected: 1 CFM = 0.028316846592 m³/min = 0.47194744 L/s
+	expected = 0.028316846592 * 1000.0 / 60.0
+	if math.Abs(flowCFM.Value-expected) > 1e-9 {
+		t.Errorf("Flow rate conversion failed: got %g L/s, expected %g L/s", flowCFM.Value, expected)
+	}
+}
+
+func TestFlowRateBaseConsistency(t *testing.T) {
+	// Every unit must map 1 m³/h to a value and back without drift
+	units := []FlowRateUnit{
+		FlowRate.CubicMetersPerHour,
+		FlowRate.LitersPerSecond,
+		FlowRate.CFM,
+	}
+
+	original := NewFlowRate(1.0, FlowRate.CubicMetersPerHour)
+	for _, u := range units {
+		t.Run(u.Name(), func(t *testing.T) {
+			roundTrip := original.ConvertTo(u).ConvertTo(FlowRate.CubicMetersPerHour)
+			if math.Abs(roundTrip.Value-original.Value) > 1e-12 {
+				t.Errorf("Round-trip through %s drifted: got %g m³/h, expected %g m³/h",
+					u.Symbol(), roundTrip.Value, original.Value)
+			}
+		})
+	}
+}
+
+func TestFlowRateCompactKeyCFM(t *testing.T) {
+	original := NewFlowRate(100.0, FlowRate.CFM)
+
+	data, err := MarshalCompactFlowRate(original)
+	if err != nil {
+		t.Fatalf("MarshalCompactFlowRate failed: %v", err)
+	}
+
+	restored, err := UnmarshalCompactFlowRate(data)
+	if err != nil {
+		t.Fatalf("UnmarshalCompactFlowRate failed: %v", err)
+	}
+	if !restored.Unit.Equals(FlowRate.CFM) || restored.Value != 100.0 {
+		t.Errorf("Round-trip failed: got %v, expected %v", restored, original)
+	}
+
+	// The legacy key is still accepted
+	legacy, err := UnmarshalCompactFlowRate([]byte(`{"value":100,"unit":"flowrate_c_f_m"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalCompactFlowRate with legacy key failed: %v", err)
+	}
+	if !legacy.Unit.Equals(FlowRate.CFM) {
+		t.Errorf("Legacy key resolved to %s, expected CFM", legacy.Unit.Symbol())
+	}
+}
@@ -81,3 +81,25 @@ func (u FuelEfficiencyUnit) ConvertFromBaseUnit(value float64) float64 {
 	// For other units, use the standard conversion
 	return u.BaseUnit.ConvertFromBaseUnit(value)
 }
+
+// linearFactors reports L/100km as non-linear, since it is the reciprocal of km/L
+func (u FuelEfficiencyUnit) linearFactors() (scale, offset float64, ok bool) {
+	if u.Symbol() == "L/100km" {
+		return 0, 0, false
+	}
+	return u.BaseUnit.linearFactors()
+}
+
+// FuelUsed returns the volume of fuel, in liters, needed to cover distance at
+// the given efficiency. Efficiency is first normalized to km/L: for L/100km
+// this takes the reciprocal (8 L/100km = 12.5 km/L) rather than scaling, so
+// both representations give the same fuel volume. It panics if the
+// efficiency is 0 km/L, which would need infinite fuel.
+func FuelUsed(efficiency Quantity[FuelEfficiencyUnit], distance Quantity[LengthUnit]) Quantity[VolumeUnit] {
+	kmPerLiter := efficiency.Unit.ConvertToBaseUnit(efficiency.Value)
+	if kmPerLiter == 0 {
+		panic("unit: cannot compute fuel used at 0 km/L")
+	}
+	km := distance.ConvertTo(Length.Kilometer).Value
+	return NewVolume(km/kmPerLiter, Volume.Liter)
+}
@@ -158,3 +158,37 @@ func TestFuelEfficiencySerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization with L/100km failed: got %v, expected %v", fe2, fe)
 	}
 }
+
+func TestFuelUsed(t *testing.T) {
+	testCases := []struct {
+		name       string
+		efficiency Quantity[FuelEfficiencyUnit]
+		distance   Quantity[LengthUnit]
+		liters     float64
+		tolerance  float64
+	}{
+		{"km/L", NewFuelEfficiency(10, FuelEfficiency.KilometersPerLiter), NewLength(100, Length.Kilometer), 10, 1e-9},
+		{"L/100km", NewFuelEfficiency(8, FuelEfficiency.LitersPer100Kilometers), NewLength(250, Length.Kilometer), 20, 1e-9},
+		{"L/100km in meters", NewFuelEfficiency(8, FuelEfficiency.LitersPer100Kilometers), NewLength(250000, Length.Meter), 20, 1e-9},
+		// 300 mi at 30 mpg is 10 US gallons; the mpg factor is rounded
+		{"mpg", NewFuelEfficiency(30, FuelEfficiency.MilesPerGallon), NewLength(300, Length.Mile), 37.854, 1e-3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := FuelUsed(tc.efficiency, tc.distance)
+			if !got.Unit.Equals(Volume.Liter) {
+				t.Errorf("unit = %s, expected L", got.Unit.Symbol())
+			}
+			if math.Abs(got.Value-tc.liters) > tc.tolerance {
+				t.Errorf("FuelUsed = %g L, expected %g L", got.Value, tc.liters)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for 0 km/L")
+		}
+	}()
+	FuelUsed(NewFuelEfficiency(0, FuelEfficiency.KilometersPerLiter), NewLength(1, Length.Kilometer))
+}
@@ -0,0 +1,66 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// LatLon is a geographic coordinate given as a latitude and longitude angle
+type LatLon struct {
+	Lat Quantity[AngleUnit]
+	Lon Quantity[AngleUnit]
+}
+
+// NewLatLon creates a coordinate, returning an error if latitude is outside
+// [-90°, 90°] or longitude is outside [-180°, 180°]
+func NewLatLon(lat, lon Quantity[AngleUnit]) (LatLon, error) {
+	c := LatLon{Lat: lat, Lon: lon}
+	if err := c.Validate(); err != nil {
+		return LatLon{}, err
+	}
+	return c, nil
+}
+
+// Validate checks that latitude is within [-90°, 90°] and longitude within [-180°, 180°]
+func (c LatLon) Validate() error {
+	lat, lon := c.degrees()
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude %g° out of range [-90°, 90°]", lat)
+	}
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return fmt.Errorf("longitude %g° out of range [-180°, 180°]", lon)
+	}
+	return nil
+}
+
+// degrees returns latitude and longitude in decimal degrees
+func (c LatLon) degrees() (lat, lon float64) {
+	return c.Lat.ConvertTo(Angle.Degree).Value, c.Lon.ConvertTo(Angle.Degree).Value
+}
+
+// String returns the coordinate in decimal degrees with hemispheres,
+// e.g. "51.5074° N, 0.1278° W"
+func (c LatLon) String() string {
+	lat, lon := c.degrees()
+	ns, ew := "N", "E"
+	if lat < 0 {
+		ns = "S"
+	}
+	if lon < 0 {
+		ew = "W"
+	}
+	return fmt.Sprintf("%.4f° %s, %.4f° %s", math.Abs(lat), ns, math.Abs(lon), ew)
+}
+
+// MarshalJSON encodes the coordinate as a GeoJSON position [lon, lat] in
+// decimal degrees
+func (c LatLon) MarshalJSON() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	lat, lon := c.degrees()
+	return json.Marshal([2]float64{lon, lat})
+}
@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestLatLonString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lat, lon Quantity[AngleUnit]
+		expected string
+	}{
+		{"London", NewAngle(51.5074, Angle.Degree), NewAngle(-0.1278, Angle.Degree), "51.5074° N, 0.1278° W"},
+		{"Sydney", NewAngle(-33.8688, Angle.Degree), NewAngle(151.2093, Angle.Degree), "33.8688° S, 151.2093° E"},
+		{"radians", NewAngle(math.Pi/4, Angle.Radian), NewAngle(-math.Pi/2, Angle.Radian), "45.0000° N, 90.0000° W"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewLatLon(tc.lat, tc.lon)
+			if err != nil {
+				t.Fatalf("NewLatLon: %v", err)
+			}
+			if got := c.String(); got != tc.expected {
+				t.Errorf("String() = %q, expected %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestLatLonMarshalJSON(t *testing.T) {
+	c, err := NewLatLon(NewAngle(51.5074, Angle.Degree), NewAngle(-0.1278, Angle.Degree))
+	if err != nil {
+		t.Fatalf("NewLatLon: %v", err)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if got := string(data); got != "[-0.1278,51.5074]" {
+		t.Errorf("json.Marshal = %s, expected [-0.1278,51.5074] (GeoJSON order)", got)
+	}
+}
+
+func TestLatLonValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lat, lon Quantity[AngleUnit]
+	}{
+		{"latitude above 90°", NewAngle(91, Angle.Degree), NewAngle(0, Angle.Degree)},
+		{"latitude below -90°", NewAngle(-math.Pi, Angle.Radian), NewAngle(0, Angle.Degree)},
+		{"longitude above 180°", NewAngle(0, Angle.Degree), NewAngle(181, Angle.Degree)},
+		{"longitude in revolutions", NewAngle(0, Angle.Degree), NewAngle(-0.75, Angle.Revolution)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewLatLon(tc.lat, tc.lon); err == nil {
+				t.Error("Expected error for out-of-range coordinate")
+			}
+			if _, err := json.Marshal(LatLon{Lat: tc.lat, Lon: tc.lon}); err == nil {
+				t.Error("Expected MarshalJSON error for out-of-range coordinate")
+			}
+		})
+	}
+
+	// Bounds are inclusive
+	if _, err := NewLatLon(NewAngle(-90, Angle.Degree), NewAngle(180, Angle.Degree)); err != nil {
+		t.Errorf("Expected boundary coordinate to be valid: %v", err)
+	}
+}
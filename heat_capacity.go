@@ -0,0 +1,52 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// HeatCapacityUnit represents a unit of heat capacity or entropy (energy per
+// temperature) of a whole body, not per unit mass as specific heat is
+type HeatCapacityUnit struct {
+	BaseUnit
+}
+
+// HeatCapacity contains predefined heat capacity units
+var HeatCapacity = struct {
+	JoulesPerKelvin     HeatCapacityUnit
+	KilojoulesPerKelvin HeatCapacityUnit
+	CaloriesPerKelvin   HeatCapacityUnit
+}{
+	JoulesPerKelvin: HeatCapacityUnit{
+		BaseUnit: NewBaseUnit(
+			"heat_capacity",
+			"J/K",
+			"Joules per Kelvin",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	KilojoulesPerKelvin: HeatCapacityUnit{
+		BaseUnit: NewBaseUnit(
+			"heat_capacity",
+			"kJ/K",
+			"Kilojoules per Kelvin",
+			1000.0, // 1 kJ/K = 1000 J/K
+			0.0,
+			false,
+		),
+	},
+	CaloriesPerKelvin: HeatCapacityUnit{
+		BaseUnit: NewBaseUnit(
+			"heat_capacity",
+			"cal/K",
+			"Calories per Kelvin",
+			4.184, // Thermochemical calorie: 1 cal/K = 4.184 J/K
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewHeatCapacity creates a new heat capacity quantity
+func NewHeatCapacity(value float64, unit HeatCapacityUnit) Quantity[HeatCapacityUnit] {
+	return New(value, unit)
+}
@@ -0,0 +1,55 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHeatCapacityConversion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[HeatCapacityUnit]
+		to       HeatCapacityUnit
+		expected float64
+	}{
+		{"kJ/K to J/K", NewHeatCapacity(1, HeatCapacity.KilojoulesPerKelvin), HeatCapacity.JoulesPerKelvin, 1000},
+		{"thermochemical calorie", NewHeatCapacity(1, HeatCapacity.CaloriesPerKelvin), HeatCapacity.JoulesPerKelvin, 4.184},
+		// One kilogram of water takes 1000 cal to warm by one kelvin
+		{"kilogram of water", NewHeatCapacity(4.184, HeatCapacity.KilojoulesPerKelvin), HeatCapacity.CaloriesPerKelvin, 1000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.ConvertTo(tc.to).Value; math.Abs(got-tc.expected) > 1e-9*tc.expected {
+				t.Errorf("%v = %g %s, expected %g", tc.from, got, tc.to.Symbol(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestHeatCapacityBesideSpecificHeat(t *testing.T) {
+	// "heat_capacity" is a suffix of "specific_heat_capacity"; keys of either
+	// dimension must split to their own
+	testCases := []struct {
+		key      string
+		expected string
+	}{
+		{"heat_capacity_joules_per_kelvin", "heat_capacity"},
+		{"heat_capacity_calories_per_kelvin", "heat_capacity"},
+		{"specific_heat_capacity_joules_per_kilogram_kelvin", "specific_heat_capacity"},
+	}
+
+	for _, tc := range testCases {
+		if dim, _ := splitUnitKey(tc.key); dim != tc.expected {
+			t.Errorf("splitUnitKey(%q) dimension = %q, expected %q", tc.key, dim, tc.expected)
+		}
+	}
+
+	q, err := ParseQuantity[HeatCapacityUnit]("2.5 kJ/K")
+	if err != nil || q.Value != 2.5 || !q.Unit.Equals(HeatCapacity.KilojoulesPerKelvin) {
+		t.Errorf("ParseQuantity[HeatCapacityUnit] = %v, %v", q, err)
+	}
+	if q, err := ParseQuantity[HeatCapacityUnit]("4186 J/(kg·K)"); err == nil {
+		t.Errorf("ParseQuantity[HeatCapacityUnit] of a specific heat = %v, expected an error", q)
+	}
+}
@@ -0,0 +1,146 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"math"
+	"strconv"
+	"sync"
+)
+
+// UnitSystem selects the family of units that BestUnit and Humanize pick from
+type UnitSystem int
+
+const (
+	// SI scales with metric prefixes, e.g. km, m, cm
+	SI UnitSystem = iota
+	// Imperial uses US customary units, e.g. mi, ft, in
+	Imperial
+	// CGS uses the centimetre-gram-second system, e.g. cm, g, cm³
+	CGS
+)
+
+// String returns the name of the unit system
+func (s UnitSystem) String() string {
+	switch s {
+	case SI:
+		return "SI"
+	case Imperial:
+		return "Imperial"
+	case CGS:
+		return "CGS"
+	}
+	return "UnitSystem(" + strconv.Itoa(int(s)) + ")"
+}
+
+// systemUnits lists, per unit system and dimension, the symbols BestUnit may
+// choose from, ordered from the largest to the smallest unit
+var systemUnits = map[UnitSystem]map[string][]string{
+	SI: {
+		"length":   {"km", "m", "cm", "mm", "µm", "nm"},
+		"mass":     {"t", "kg", "g", "mg", "µg"},
+		"volume":   {"m³", "L", "mL"},
+		"area":     {"km²", "m²", "cm²", "mm²"},
+		"duration": {"d", "h", "min", "s", "ms", "µs", "ns"},
+	},
+	Imperial: {
+		"length":   {"mi", "ft", "in"},
+		"mass":     {"ton", "lb", "oz"},
+		"volume":   {"gal", "qt", "pt", "fl oz"},
+		"area":     {"mi²", "ac", "ft²", "in²"},
+		"duration": {"d", "h", "min", "s", "ms", "µs", "ns"},
+	},
+	CGS: {
+		"length":   {"cm", "mm", "µm", "nm"},
+		"mass":     {"g", "mg", "µg"},
+		"volume":   {"cm³", "mm³"},
+		"area":     {"cm²", "mm²"},
+		"duration": {"d", "h", "min", "s", "ms", "µs", "ns"},
+	},
+}
+
+// defaultUnitSystem is the unit system used by BestUnit and Humanize
+var defaultUnitSystem = struct {
+	sync.RWMutex
+	system UnitSystem
+}{system: SI}
+
+// SetDefaultUnitSystem sets the unit system used by BestUnit and Humanize.
+// BestUnitIn and HumanizeIn override it for a single call.
+func SetDefaultUnitSystem(system UnitSystem) {
+	defaultUnitSystem.Lock()
+	defer defaultUnitSystem.Unlock()
+	defaultUnitSystem.system = system
+}
+
+// DefaultUnitSystem returns the unit system used by BestUnit and Humanize
+func DefaultUnitSystem() UnitSystem {
+	defaultUnitSystem.RLock()
+	defer defaultUnitSystem.RUnlock()
+	return defaultUnitSystem.system
+}
+
+// BestUnit returns m converted to the most readable unit of the default unit
+// system; see BestUnitIn
+func (m Quantity[T]) BestUnit() Quantity[T] {
+	return m.BestUnitIn(DefaultUnitSystem())
+}
+
+// BestUnitIn returns m converted to the largest unit of system in which its
+// magnitude, rounded as by Humanize, is at least 1, e.g. 1609 m → 1.609 km
+// (SI) or 0.9998 mi (Imperial). Values below 1 of the smallest unit use the
+// smallest unit. m is returned unchanged if it is zero or not finite, or if
+// system has no units for its dimension.
+func (m Quantity[T]) BestUnitIn(system UnitSystem) Quantity[T] {
+	symbols := systemUnits[system][m.Unit.Dimension()]
+	r, ok := registries[m.Unit.Dimension()]
+	base := m.Unit.ConvertToBaseUnit(m.Value)
+	if len(symbols) == 0 || !ok || base == 0 || math.IsInf(base, 0) || math.IsNaN(base) {
+		return m
+	}
+
+	var best T
+	for _, symbol := range symbols {
+		u, ok := r.resolve(symbol)
+		if !ok {
+			continue
+		}
+		best = u.(T)
+		if math.Abs(humanRound(best.ConvertFromBaseUnit(base))) >= 1 {
+			break
+		}
+	}
+	return m.ConvertTo(best)
+}
+
+// Humanize formats m in the most readable unit of the default unit system;
+// see HumanizeIn
+func (m Quantity[T]) Humanize() string {
+	return m.HumanizeIn(DefaultUnitSystem())
+}
+
+// HumanizeIn formats m in the unit chosen by BestUnitIn, rounded to three
+// decimals (three significant digits below 1). Rounded values are marked
+// with "≈", e.g. 1609 m is "1.609 km" in SI and "≈1 mi" in Imperial.
+func (m Quantity[T]) HumanizeIn(system UnitSystem) string {
+	best := m.BestUnitIn(system)
+	rounded := humanRound(best.Value)
+	s := strconv.FormatFloat(rounded, 'f', -1, 64) + " " + best.Unit.Symbol()
+	if rounded != best.Value && !math.IsNaN(best.Value) {
+		return "≈" + s
+	}
+	return s
+}
+
+// humanRound rounds v to three decimals, or to three significant digits if
+// its magnitude is below 1
+func humanRound(v float64) float64 {
+	if math.Abs(v) >= 1e15 || math.IsNaN(v) {
+		return v // no fractional digits left to round
+	}
+	if math.Abs(v) >= 1 {
+		return math.Round(v*1000) / 1000
+	}
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(v, 'g', 3, 64), 64)
+	return rounded
+}
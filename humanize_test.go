@@ -0,0 +1,106 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHumanizeIn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		q        Quantity[LengthUnit]
+		system   UnitSystem
+		expected string
+	}{
+		{"SI km", NewLength(1609, Length.Meter), SI, "1.609 km"},
+		{"Imperial mi", NewLength(1609, Length.Meter), Imperial, "≈1 mi"},
+		{"CGS cm", NewLength(1609, Length.Meter), CGS, "160900 cm"},
+		{"SI m", NewLength(250, Length.Meter), SI, "250 m"},
+		{"SI mm", NewLength(0.0042, Length.Meter), SI, "4.2 mm"},
+		{"Imperial ft", NewLength(3, Length.Meter), Imperial, "≈9.843 ft"},
+		{"Imperial in", NewLength(1, Length.Inch), Imperial, "1 in"},
+		{"SI smallest", NewLength(0.0001, Length.Nanometer), SI, "0.0001 nm"},
+		{"negative", NewLength(-1500, Length.Meter), SI, "-1.5 km"},
+		{"zero unchanged", NewLength(0, Length.Mile), SI, "0 mi"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.q.HumanizeIn(tc.system); got != tc.expected {
+				t.Errorf("HumanizeIn(%v) = %q, expected %q", tc.system, got, tc.expected)
+			}
+		})
+	}
+
+	// Dimensions without units in a system are left as they are
+	speed := NewSpeed(10, Speed.MetersPerSecond)
+	if got := speed.HumanizeIn(Imperial); got != "10 m/s" {
+		t.Errorf("HumanizeIn(speed) = %q, expected 10 m/s", got)
+	}
+}
+
+func TestBestUnitIn(t *testing.T) {
+	testCases := []struct {
+		q      Quantity[MassUnit]
+		system UnitSystem
+		unit   MassUnit
+	}{
+		{NewMass(2500, Mass.Kilogram), SI, Mass.MetricTon},
+		{NewMass(0.5, Mass.Kilogram), SI, Mass.Gram},
+		{NewMass(0.5, Mass.Kilogram), Imperial, Mass.Pound},
+		{NewMass(0.5, Mass.Kilogram), CGS, Mass.Gram},
+	}
+	for _, tc := range testCases {
+		got := tc.q.BestUnitIn(tc.system)
+		if !got.Unit.Equals(tc.unit) {
+			t.Errorf("BestUnitIn(%v, %v) = %v, expected unit %s", tc.q, tc.system, got, tc.unit.Symbol())
+		}
+		if !got.Equal(tc.q) {
+			t.Errorf("BestUnitIn(%v, %v) = %v changed the quantity", tc.q, tc.system, got)
+		}
+	}
+}
+
+func TestBestUnitDuration(t *testing.T) {
+	// Durations use the same units in every system, as BestDurationUnit does
+	testCases := []struct {
+		q        Quantity[DurationUnit]
+		expected Quantity[DurationUnit]
+	}{
+		{NewDuration(90000, Duration.Millisecond), NewDuration(1.5, Duration.Minute)},
+		{NewDuration(0.0005, Duration.Second), NewDuration(500, Duration.Microsecond)},
+		{NewDuration(36, Duration.Hour), NewDuration(1.5, Duration.Day)},
+	}
+	for _, tc := range testCases {
+		for _, system := range []UnitSystem{SI, Imperial, CGS} {
+			got := tc.q.BestUnitIn(system)
+			if !got.Unit.Equals(tc.expected.Unit) || math.Abs(got.Value-tc.expected.Value) > 1e-9 {
+				t.Errorf("BestUnitIn(%v, %v) = %v, expected %v", tc.q, system, got, tc.expected)
+			}
+		}
+	}
+
+	if got := NewDuration(90000, Duration.Millisecond).BestUnit(); !got.Unit.Equals(Duration.Minute) || got.Value != 1.5 {
+		t.Errorf("BestUnit(90000 ms) = %v, expected 1.5 min", got)
+	}
+}
+
+func TestDefaultUnitSystem(t *testing.T) {
+	defer SetDefaultUnitSystem(DefaultUnitSystem())
+
+	l := NewLength(1609, Length.Meter)
+	if got := l.Humanize(); got != "1.609 km" {
+		t.Errorf("Humanize() with default SI = %q, expected 1.609 km", got)
+	}
+
+	SetDefaultUnitSystem(Imperial)
+	if got := l.Humanize(); got != "≈1 mi" {
+		t.Errorf("Humanize() with default Imperial = %q, expected ≈1 mi", got)
+	}
+	if got := l.BestUnit(); !got.Unit.Equals(Length.Mile) {
+		t.Errorf("BestUnit() with default Imperial = %v, expected mi", got)
+	}
+	// A per-call system overrides the default
+	if got := l.HumanizeIn(SI); got != "1.609 km" {
+		t.Errorf("HumanizeIn(SI) = %q, expected 1.609 km", got)
+	}
+}
@@ -2,6 +2,16 @@
 // physical quantities with units.
 package unit
 
+// Illuminance conversion factors
+const (
+	// LuxPerFootCandle is the exact number of lux in one foot-candle (1 lm/ft² = 1/0.09290304 lx)
+	LuxPerFootCandle = 1 / 0.09290304
+	// LuxPerPhot is the number of lux in one phot (1 lm/cm²)
+	LuxPerPhot = 10000.0
+	// LuxPerNox is the number of lux in one nox
+	LuxPerNox = 0.001
+)
+
 // IlluminanceUnit represents a unit of illuminance
 type IlluminanceUnit struct {
 	BaseUnit
@@ -29,7 +39,7 @@ var Illuminance = struct {
 			"illuminance",
 			"fc",
 			"Foot-candle",
-			10.7639, // 1 fc = 10.7639 lx
+			LuxPerFootCandle, // 1 fc ≈ 10.7639 lx
 			0.0,
 			false,
 		),
@@ -39,7 +49,7 @@ var Illuminance = struct {
 			"illuminance",
 			"ph",
 			"Phot",
-			10000.0, // 1 ph = 10,000 lx
+			LuxPerPhot, // 1 ph = 10,000 lx
 			0.0,
 			false,
 		),
@@ -49,7 +59,7 @@ var Illuminance = struct {
 			"illuminance",
 			"nx",
 			"Nox",
-			0.001, // 1 nx = 0.001 lx
+			LuxPerNox, // 1 nx = 0.001 lx
 			0.0,
 			false,
 		),
@@ -14,8 +14,8 @@ func TestIlluminanceConversion(t *testing.T) {
 	illumFc := illumLux.ConvertTo(Illuminance.FootCandle)
 
 	// Expected: 1000 lx ≈ 92.9 fc
-	expected := 1000.0 / 10.7639
-	if math.Abs(illumFc.Value-expected) > 0.01 {
+	expected := 1000.0 / 10.763910416709722
+	if math.Abs(illumFc.Value-expected) > 1e-9 {
 		t.Errorf("Illuminance conversion failed: got %g fc, expected %g fc", illumFc.Value, expected)
 	}
 
@@ -55,7 +55,7 @@ func TestIlluminanceArithmetic(t *testing.T) {
 	sum := illum1.Add(illum2)
 
 	// Expected: 1000 lx + (10 fc * 10.7639 lx/fc) ≈ 1107.64 lx
-	expected := 1000.0 + (10.0 * 10.7639)
+	expected := 1000.0 + (10.0 * LuxPerFootCandle)
 	if math.Abs(sum.Value-expected) > 0.01 {
 		t.Errorf("Addition failed: got %g lx, expected %g lx", sum.Value, expected)
 	}
@@ -64,7 +64,7 @@ func TestIlluminanceArithmetic(t *testing.T) {
 	diff := illum1.Subtract(illum2)
 
 	// Expected: 1000 lx - (10 fc * 10.7639 lx/fc) ≈ 892.36 lx
-	expected = 1000.0 - (10.0 * 10.7639)
+	expected = 1000.0 - (10.0 * LuxPerFootCandle)
 	if math.Abs(diff.Value-expected) > 0.01 {
 		t.Errorf("Subtraction failed: got %g lx, expected %g lx", diff.Value, expected)
 	}
@@ -130,3 +130,20 @@ func TestIlluminanceSerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization failed: got %v, expected %v", illum2, illum)
 	}
 }
+
+func TestFootCandleExactFactor(t *testing.T) {
+	// 1 fc = 1 lm/ft² = 1/0.09290304 lx exactly
+	lux := NewIlluminance(1.0, Illuminance.FootCandle).ConvertTo(Illuminance.Lux)
+	if math.Abs(lux.Value-10.763910416709722) > 1e-12 {
+		t.Errorf("Expected 1 fc = 10.763910416709722 lx, got %.15g lx", lux.Value)
+	}
+
+	// Round-trip fc -> lx -> fc
+	for _, v := range []float64{0.5, 1, 37.25, 12345.678} {
+		original := NewIlluminance(v, Illuminance.FootCandle)
+		roundTrip := original.ConvertTo(Illuminance.Lux).ConvertTo(Illuminance.FootCandle)
+		if math.Abs(roundTrip.Value-v) > 1e-9*v {
+			t.Errorf("Round-trip conversion failed: got %.15g fc, expected %g fc", roundTrip.Value, v)
+		}
+	}
+}
@@ -2,6 +2,11 @@
 // physical quantities with units.
 package unit
 
+import (
+	"math"
+	"math/big"
+)
+
 // InformationUnit represents a unit of information
 type InformationUnit struct {
 	BaseUnit
@@ -21,6 +26,9 @@ var Information = struct {
 	Gibibyte InformationUnit
 	Tebibyte InformationUnit
 	Pebibyte InformationUnit
+	Kibibit  InformationUnit
+	Mebibit  InformationUnit
+	Gibibit  InformationUnit
 }{
 	Bit: InformationUnit{
 		BaseUnit: NewBaseUnit(
@@ -142,9 +150,73 @@ var Information = struct {
 			false,
 		),
 	},
+	Kibibit: InformationUnit{
+		BaseUnit: NewBaseUnit(
+			"information",
+			"Kibit",
+			"Kibibit",
+			128.0, // 1 Kibit = 1,024 bits = 128 bytes
+			0.0,
+			false,
+		),
+	},
+	Mebibit: InformationUnit{
+		BaseUnit: NewBaseUnit(
+			"information",
+			"Mibit",
+			"Mebibit",
+			131072.0, // 1 Mibit = 1,048,576 bits = 131,072 bytes
+			0.0,
+			false,
+		),
+	},
+	Gibibit: InformationUnit{
+		BaseUnit: NewBaseUnit(
+			"information",
+			"Gibit",
+			"Gibibit",
+			134217728.0, // 1 Gibit = 1,073,741,824 bits = 134,217,728 bytes
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewInformation creates a new information measurement
 func NewInformation(value float64, unit InformationUnit) Quantity[InformationUnit] {
 	return New(value, unit)
 }
+
+// ExactBytes returns m as an exact integer number of bytes, computing the
+// product of value and unit factor without float64 rounding. ok is false if
+// the result is negative, not a whole number of bytes, or exceeds uint64.
+func ExactBytes(m Quantity[InformationUnit]) (uint64, bool) {
+	scale, _, _ := m.Unit.linearFactors()
+	// 128 bits of mantissa hold the product of two float64 values exactly
+	bytes := new(big.Float).SetPrec(128).SetFloat64(scale)
+	bytes.Mul(bytes, new(big.Float).SetFloat64(m.Value))
+	if !bytes.IsInt() || bytes.Sign() < 0 {
+		return 0, false
+	}
+	n, accuracy := bytes.Uint64()
+	return n, accuracy == big.Exact
+}
+
+// AddExact adds two information quantities as integer byte counts, which stays
+// exact beyond 2^53 bytes where float64 addition (as in Add) loses precision.
+// If either operand is not a whole number of bytes or the sum overflows uint64,
+// it falls back to float64 addition and returns exact=false; the result may
+// then be rounded, and is 0 if the float sum is negative or out of range.
+func AddExact(a, b Quantity[InformationUnit]) (bytes uint64, exact bool) {
+	x, okA := ExactBytes(a)
+	y, okB := ExactBytes(b)
+	if okA && okB && x <= math.MaxUint64-y {
+		return x + y, true
+	}
+
+	sum := a.Unit.ConvertToBaseUnit(a.Value) + b.Unit.ConvertToBaseUnit(b.Value)
+	if sum < 0 || sum >= math.MaxUint64 || math.IsNaN(sum) {
+		return 0, false
+	}
+	return uint64(sum), false
+}
@@ -126,3 +126,185 @@ func TestInformationSerialization(t *testing.T) {
 		t.Errorf("Expected deserialized unit to be %s, got %s", info.Unit.Symbol(), infoDeserialized.Unit.Symbol())
 	}
 }
+
+func TestExactBytes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		info     Quantity[InformationUnit]
+		expected uint64
+		ok       bool
+	}{
+		{"Bytes", NewInformation(1024, Information.Byte), 1024, true},
+		{"Bits", NewInformation(16, Information.Bit), 2, true},
+		{"Pebibytes", NewInformation(3, Information.Pebibyte), 3 * 1125899906842624, true},
+		{"Fractional byte", NewInformation(4, Information.Bit), 0, false},
+		{"Negative", NewInformation(-1, Information.Byte), 0, false},
+		{"Too large", NewInformation(1e6, Information.Pebibyte), 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, ok := ExactBytes(tc.info)
+			if ok != tc.ok || (ok && n != tc.expected) {
+				t.Errorf("ExactBytes(%v) = (%d, %t), expected (%d, %t)", tc.info, n, ok, tc.expected, tc.ok)
+			}
+		})
+	}
+}
+
+func TestAddExact(t *testing.T) {
+	// 2^53 + 1 bytes cannot be represented as float64
+	a := NewInformation(1<<53, Information.Byte)
+	b := NewInformation(1, Information.Byte)
+
+	// float64 addition rounds the sum back down to 2^53
+	if float := a.Add(b); float.Value != 1<<53 {
+		t.Errorf("Expected float64 addition to round to 2^53 B, got %v", float)
+	}
+
+	sum, exact := AddExact(a, b)
+	if !exact || sum != 1<<53+1 {
+		t.Errorf("AddExact(2^53 B, 1 B) = (%d, %t), expected (%d, true)", sum, exact, uint64(1<<53+1))
+	}
+
+	// Mixed units: 8 PiB + 1 bit*8 = 8 PiB + 1 B
+	sum, exact = AddExact(NewInformation(8, Information.Pebibyte), NewInformation(8, Information.Bit))
+	if !exact || sum != 8*1125899906842624+1 {
+		t.Errorf("AddExact(8 PiB, 8 bit) = (%d, %t), expected (%d, true)", sum, exact, uint64(8*1125899906842624+1))
+	}
+
+	// Fractional bytes fall back to float addition
+	sum, exact = AddExact(NewInformation(10, Information.Byte), NewInformation(4, Information.Bit))
+	if exact || sum != 10 {
+		t.Errorf("AddExact(10 B, 4 bit) = (%d, %t), expected (10, false)", sum, exact)
+	}
+}
+
+func TestBinaryBitUnits(t *testing.T) {
+	testCases := []struct {
+		unit  InformationUnit
+		bytes float64
+	}{
+		{Information.Kibibit, 128},
+		{Information.Mebibit, 131072},
+		{Information.Gibibit, 134217728},
+	}
+	for _, tc := range testCases {
+		got := NewInformation(1, tc.unit).ConvertTo(Information.Byte).Value
+		if got != tc.bytes {
+			t.Errorf("1 %s = %v B, expected %v", tc.unit.Symbol(), got, tc.bytes)
+		}
+		// A binary bit unit is an eighth of the matching binary byte unit
+		if bits := NewInformation(8, tc.unit).ConvertTo(Information.Bit).Value; bits != tc.bytes*64 {
+			t.Errorf("8 %s = %v bit, expected %v", tc.unit.Symbol(), bits, tc.bytes*64)
+		}
+	}
+
+	if got := NewInformation(1, Information.Kibibyte).ConvertTo(Information.Kibibit).Value; got != 8 {
+		t.Errorf("1 KiB = %v Kibit, expected 8", got)
+	}
+}
+
+func TestParseInformationBitsAndBytes(t *testing.T) {
+	testCases := []struct {
+		input string
+		unit  InformationUnit
+	}{
+		{"1 KiB", Information.Kibibyte},
+		{"1 Kibit", Information.Kibibit},
+		{"1 MiB", Information.Mebibyte},
+		{"1 Mibit", Information.Mebibit},
+		{"1 GiB", Information.Gibibyte},
+		{"1 Gibit", Information.Gibibit},
+		{"2 gibibits", Information.Gibibit},
+		{"8 bit", Information.Bit},
+		{"1 B", Information.Byte},
+	}
+	for _, tc := range testCases {
+		q, err := ParseInformation(tc.input)
+		if err != nil {
+			t.Errorf("ParseInformation(%q) error: %v", tc.input, err)
+			continue
+		}
+		if !q.Unit.Equals(tc.unit) {
+			t.Errorf("ParseInformation(%q) unit = %s, expected %s", tc.input, q.Unit.Symbol(), tc.unit.Symbol())
+		}
+	}
+
+	if _, err := ParseInformation("1 Kibyte"); err == nil {
+		t.Error("expected error for unknown unit")
+	}
+}
+
+func TestBinaryBitUnitsRoundTrip(t *testing.T) {
+	for _, unit := range []InformationUnit{Information.Kibibit, Information.Mebibit, Information.Gibibit} {
+		original := NewInformation(3, unit)
+
+		data, err := MarshalInformation(original)
+		if err != nil {
+			t.Fatalf("MarshalInformation(%s) error: %v", unit.Symbol(), err)
+		}
+		got, err := UnmarshalInformation(data)
+		if err != nil {
+			t.Fatalf("UnmarshalInformation(%s) error: %v", data, err)
+		}
+		if !got.Unit.Equals(unit) || got.Value != 3 {
+			t.Errorf("full round trip = %v %s, expected 3 %s", got.Value, got.Unit.Symbol(), unit.Symbol())
+		}
+
+		data, err = MarshalCompactInformation(original)
+		if err != nil {
+			t.Fatalf("MarshalCompactInformation(%s) error: %v", unit.Symbol(), err)
+		}
+		got, err = UnmarshalCompactInformation(data)
+		if err != nil {
+			t.Fatalf("UnmarshalCompactInformation(%s) error: %v", data, err)
+		}
+		if !got.Unit.Equals(unit) || got.Value != 3 {
+			t.Errorf("compact round trip = %v %s, expected 3 %s", got.Value, got.Unit.Symbol(), unit.Symbol())
+		}
+	}
+}
+
+func TestParseInformationCase(t *testing.T) {
+	// "b" is a bit and "B" a byte, a factor of 8 apart
+	testCases := []struct {
+		input    string
+		expected InformationUnit
+	}{
+		{"8 b", Information.Bit},
+		{"8 B", Information.Byte},
+		{"100 MB", Information.Megabyte},
+		{"1 KB", Information.Kilobyte},
+		{"1 kB", Information.Kilobyte},
+		{"1 GB", Information.Gigabyte},
+		{"3 megabytes", Information.Megabyte},
+		{"3 Megabytes", Information.Megabyte},
+		{"1 KiB", Information.Kibibyte},
+		{"4 MiB", Information.Mebibyte},
+		{"2 Kibit", Information.Kibibit},
+		{"1 kibibyte", Information.Kibibyte},
+	}
+	for _, tc := range testCases {
+		q, err := ParseInformation(tc.input)
+		if err != nil {
+			t.Errorf("ParseInformation(%q) error: %v", tc.input, err)
+			continue
+		}
+		if !q.Unit.Equals(tc.expected) {
+			t.Errorf("ParseInformation(%q) unit = %s, expected %s", tc.input, q.Unit.Symbol(), tc.expected.Symbol())
+		}
+	}
+
+	// There are no kilobit or megabit units, so these must not fall back to
+	// bytes; "Kib" and "Mib" would be kibibits, not kibibytes
+	for _, input := range []string{"100 Mb", "1 Kb", "1 kb", "1 mb", "1 gb", "1 Kib", "1 Mib", "1 kib"} {
+		if q, err := ParseInformation(input); err == nil {
+			t.Errorf("ParseInformation(%q) = %v, expected an unknown unit error", input, q)
+		}
+	}
+
+	if got := UnitAliases("information", "B"); len(got) < 2 || got[0] != "B" || got[1] != "byte" {
+		t.Errorf("UnitAliases(information, B) = %v, expected [B byte bytes]", got)
+	}
+}
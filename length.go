@@ -2,6 +2,11 @@
 // physical quantities with units.
 package unit
 
+import (
+	"math"
+	"strconv"
+)
+
 // LengthUnit represents a unit of length
 type LengthUnit struct {
 	BaseUnit
@@ -126,3 +131,59 @@ var Length = struct {
 func NewLength(value float64, unit LengthUnit) Quantity[LengthUnit] {
 	return New(value, unit)
 }
+
+// Squared returns the area of a square with side length m. The result is
+// always in the base area unit (m²); use ConvertTo for other units such as ft².
+func Squared(m Quantity[LengthUnit]) Quantity[AreaUnit] {
+	meters := m.Unit.ConvertToBaseUnit(m.Value)
+	return NewArea(meters*meters, Area.SquareMeter)
+}
+
+// Cubed returns the volume of a cube with edge length m. The result is
+// always in the base volume unit (m³); use ConvertTo for other units such as ft³.
+func Cubed(m Quantity[LengthUnit]) Quantity[VolumeUnit] {
+	meters := m.Unit.ConvertToBaseUnit(m.Value)
+	return NewVolume(meters*meters*meters, Volume.CubicMeter)
+}
+
+// FractionalInchesString formats m in inches rounded to the nearest
+// 1/denominator inch, with the fraction reduced, e.g. "3 1/2 in" for 0.0889 m
+// at denominator 16, "4 in" for a whole number of inches, and "3/8 in" below
+// one inch. It panics if denominator is not positive.
+func FractionalInchesString(m Quantity[LengthUnit], denominator int) string {
+	if denominator <= 0 {
+		panic("unit: fractional inch denominator must be positive")
+	}
+
+	inches := m.ConvertTo(Length.Inch).Value
+	sign := ""
+	if inches < 0 {
+		sign = "-"
+		inches = -inches
+	}
+
+	parts := int64(math.Round(inches * float64(denominator)))
+	den := int64(denominator)
+	whole, num := parts/den, parts%den
+	if num == 0 {
+		if whole == 0 {
+			sign = ""
+		}
+		return sign + strconv.FormatInt(whole, 10) + " in"
+	}
+
+	g := gcd(num, den)
+	fraction := strconv.FormatInt(num/g, 10) + "/" + strconv.FormatInt(den/g, 10)
+	if whole == 0 {
+		return sign + fraction + " in"
+	}
+	return sign + strconv.FormatInt(whole, 10) + " " + fraction + " in"
+}
+
+// gcd returns the greatest common divisor of two positive integers
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
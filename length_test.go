@@ -233,3 +233,85 @@ func TestLengthSerialization(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromStrings(t *testing.T) {
+	testCases := []struct {
+		name         string
+		value        string
+		unit         string
+		expected     float64
+		expectedUnit LengthUnit
+	}{
+		{"By symbol", "5", "km", 5.0, Length.Kilometer},
+		{"By name", "2.5", "Meter", 2.5, Length.Meter},
+		{"Negative value", "-3", "ft", -3.0, Length.Foot},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := NewFromStrings[LengthUnit](tc.value, tc.unit)
+			if err != nil {
+				t.Fatalf("NewFromStrings(%q, %q) failed: %v", tc.value, tc.unit, err)
+			}
+			if !approxEqual(result.Value, tc.expected) || !result.Unit.Equals(tc.expectedUnit) {
+				t.Errorf("NewFromStrings(%q, %q) = %v, expected %g %s",
+					tc.value, tc.unit, result, tc.expected, tc.expectedUnit.Symbol())
+			}
+		})
+	}
+
+	if _, err := NewFromStrings[LengthUnit]("5", "parsec"); err == nil {
+		t.Error("Expected error for unknown unit, got nil")
+	}
+	if _, err := NewFromStrings[LengthUnit]("five", "m"); err == nil {
+		t.Error("Expected error for malformed value, got nil")
+	}
+}
+
+func TestSquaredAndCubed(t *testing.T) {
+	area := Squared(NewLength(3, Length.Meter))
+	if !approxEqual(area.Value, 9) || !area.Unit.Equals(Area.SquareMeter) {
+		t.Errorf("Squared(3 m) = %v, expected 9 m²", area)
+	}
+
+	volume := Cubed(NewLength(2, Length.Meter))
+	if !approxEqual(volume.Value, 8) || !volume.Unit.Equals(Volume.CubicMeter) {
+		t.Errorf("Cubed(2 m) = %v, expected 8 m³", volume)
+	}
+
+	// Non-base input is converted to meters first: 1 ft² = 0.09290304 m²
+	area = Squared(NewLength(1, Length.Foot))
+	if !approxEqual(area.ConvertTo(Area.SquareFoot).Value, 1) {
+		t.Errorf("Squared(1 ft) = %v, expected 1 ft²", area.ConvertTo(Area.SquareFoot))
+	}
+}
+
+func TestFractionalInchesString(t *testing.T) {
+	testCases := []struct {
+		m           Quantity[LengthUnit]
+		denominator int
+		expected    string
+	}{
+		{NewLength(0.0889, Length.Meter), 16, "3 1/2 in"},
+		{NewLength(4, Length.Inch), 16, "4 in"},
+		{NewLength(0.375, Length.Inch), 16, "3/8 in"},
+		{NewLength(2.3, Length.Inch), 8, "2 1/4 in"},
+		{NewLength(2.99, Length.Inch), 16, "3 in"},
+		{NewLength(1, Length.Foot), 4, "12 in"},
+		{NewLength(-1.5, Length.Inch), 2, "-1 1/2 in"},
+		{NewLength(0.01, Length.Inch), 4, "0 in"},
+		{NewLength(-0.01, Length.Inch), 4, "0 in"},
+	}
+	for _, tc := range testCases {
+		if got := FractionalInchesString(tc.m, tc.denominator); got != tc.expected {
+			t.Errorf("FractionalInchesString(%v, %d) = %q, expected %q", tc.m, tc.denominator, got, tc.expected)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for zero denominator")
+		}
+	}()
+	FractionalInchesString(NewLength(1, Length.Inch), 0)
+}
@@ -0,0 +1,63 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// LinearDensityUnit represents a unit of linear density (mass per length), such
+// as the weight of a cable or the fineness of a yarn
+type LinearDensityUnit struct {
+	BaseUnit
+}
+
+// LinearDensity contains predefined linear density units
+var LinearDensity = struct {
+	KilogramsPerMeter LinearDensityUnit
+	GramsPerMeter     LinearDensityUnit
+	Tex               LinearDensityUnit
+	Denier            LinearDensityUnit
+}{
+	KilogramsPerMeter: LinearDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"linear_density",
+			"kg/m",
+			"Kilograms per Meter",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	GramsPerMeter: LinearDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"linear_density",
+			"g/m",
+			"Grams per Meter",
+			0.001, // 1 g/m = 0.001 kg/m
+			0.0,
+			false,
+		),
+	},
+	Tex: LinearDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"linear_density",
+			"tex",
+			"Tex",
+			0.000001, // 1 tex = 1 g/km = 0.000001 kg/m
+			0.0,
+			false,
+		),
+	},
+	Denier: LinearDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"linear_density",
+			"den",
+			"Denier",
+			0.000001/9, // 1 den = 1 g/9 km = 1/9 tex
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewLinearDensity creates a new linear density quantity
+func NewLinearDensity(value float64, unit LinearDensityUnit) Quantity[LinearDensityUnit] {
+	return New(value, unit)
+}
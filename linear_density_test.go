@@ -0,0 +1,56 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearDensityConversion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[LinearDensityUnit]
+		to       LinearDensityUnit
+		expected float64
+	}{
+		{"9 den is 1 tex", NewLinearDensity(9, LinearDensity.Denier), LinearDensity.Tex, 1},
+		{"tex to g/m", NewLinearDensity(1, LinearDensity.Tex), LinearDensity.GramsPerMeter, 0.001},
+		{"kg/m to g/m", NewLinearDensity(1, LinearDensity.KilogramsPerMeter), LinearDensity.GramsPerMeter, 1000},
+		// A 15 denier stocking yarn
+		{"hosiery yarn", NewLinearDensity(15, LinearDensity.Denier), LinearDensity.Tex, 15.0 / 9},
+		// A light mains cable weighs about 150 g/m
+		{"cable", NewLinearDensity(150, LinearDensity.GramsPerMeter), LinearDensity.KilogramsPerMeter, 0.15},
+		{"kg/m to denier", NewLinearDensity(0.5, LinearDensity.KilogramsPerMeter), LinearDensity.Denier, 4.5e6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.ConvertTo(tc.to).Value; math.Abs(got-tc.expected) > 1e-9*tc.expected {
+				t.Errorf("%v = %g %s, expected %g", tc.from, got, tc.to.Symbol(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseLinearDensity(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected LinearDensityUnit
+	}{
+		{"40 den", LinearDensity.Denier},
+		{"40 tex", LinearDensity.Tex},
+		{"40 g/m", LinearDensity.GramsPerMeter},
+		{"40 kg/m", LinearDensity.KilogramsPerMeter},
+	}
+
+	for _, tc := range testCases {
+		q, err := ParseQuantity[LinearDensityUnit](tc.input)
+		if err != nil || q.Value != 40 || !q.Unit.Equals(tc.expected) {
+			t.Errorf("ParseQuantity[LinearDensityUnit](%q) = %v, %v", tc.input, q, err)
+		}
+	}
+
+	// Paper weight in g/m² is an area density, not a linear one
+	if q, err := ParseQuantity[LinearDensityUnit]("80 g/m²"); err == nil {
+		t.Errorf("ParseQuantity[LinearDensityUnit](\"80 g/m²\") = %v, expected an error", q)
+	}
+}
@@ -0,0 +1,62 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// MagneticFluxDensityUnit represents a unit of magnetic flux density
+type MagneticFluxDensityUnit struct {
+	BaseUnit
+}
+
+// MagneticFluxDensity contains predefined magnetic flux density units
+var MagneticFluxDensity = struct {
+	Tesla      MagneticFluxDensityUnit
+	Millitesla MagneticFluxDensityUnit
+	Microtesla MagneticFluxDensityUnit
+	Gauss      MagneticFluxDensityUnit
+}{
+	Tesla: MagneticFluxDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"magnetic_flux_density",
+			"T",
+			"Tesla",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	Millitesla: MagneticFluxDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"magnetic_flux_density",
+			"mT",
+			"Millitesla",
+			0.001, // 1 mT = 0.001 T
+			0.0,
+			false,
+		),
+	},
+	Microtesla: MagneticFluxDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"magnetic_flux_density",
+			"µT",
+			"Microtesla",
+			0.000001, // 1 µT = 0.000001 T
+			0.0,
+			false,
+		),
+	},
+	Gauss: MagneticFluxDensityUnit{
+		BaseUnit: NewBaseUnit(
+			"magnetic_flux_density",
+			"G",
+			"Gauss",
+			0.0001, // 1 G = 0.0001 T
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewMagneticFluxDensity creates a new magnetic flux density quantity
+func NewMagneticFluxDensity(value float64, unit MagneticFluxDensityUnit) Quantity[MagneticFluxDensityUnit] {
+	return New(value, unit)
+}
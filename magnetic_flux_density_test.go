@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMagneticFluxDensityConversion(t *testing.T) {
+	testCases := []struct {
+		from     Quantity[MagneticFluxDensityUnit]
+		to       MagneticFluxDensityUnit
+		expected float64
+	}{
+		{NewMagneticFluxDensity(1, MagneticFluxDensity.Tesla), MagneticFluxDensity.Gauss, 10000},
+		{NewMagneticFluxDensity(1, MagneticFluxDensity.Gauss), MagneticFluxDensity.Microtesla, 100},
+		{NewMagneticFluxDensity(50, MagneticFluxDensity.Microtesla), MagneticFluxDensity.Millitesla, 0.05},
+		{NewMagneticFluxDensity(1.5, MagneticFluxDensity.Tesla), MagneticFluxDensity.Millitesla, 1500},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.from.ConvertTo(tc.to).Value; math.Abs(got-tc.expected) > 1e-9*tc.expected {
+			t.Errorf("%v = %g %s, expected %g", tc.from, got, tc.to.Symbol(), tc.expected)
+		}
+	}
+}
+
+func TestParseMagneticFluxDensity(t *testing.T) {
+	testCases := []struct {
+		input    string
+		value    float64
+		expected MagneticFluxDensityUnit
+	}{
+		{"1.5 T", 1.5, MagneticFluxDensity.Tesla},
+		{"3 mT", 3, MagneticFluxDensity.Millitesla},
+		{"50 µT", 50, MagneticFluxDensity.Microtesla},
+		{"50uT", 50, MagneticFluxDensity.Microtesla},
+		{"0.5 G", 0.5, MagneticFluxDensity.Gauss},
+		{"2 gauss", 2, MagneticFluxDensity.Gauss},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseMagneticFluxDensity(tc.input)
+		if err != nil || got.Value != tc.value || !got.Unit.Equals(tc.expected) {
+			t.Errorf("ParseMagneticFluxDensity(%q) = %v, %v", tc.input, got, err)
+		}
+	}
+
+	if _, err := ParseMagneticFluxDensity("3 kg"); err == nil {
+		t.Error("expected an error for a mass unit")
+	}
+}
+
+func TestMagneticFluxDensitySymbolsDoNotCollide(t *testing.T) {
+	// "T" and "G" resolve by the declared dimension, next to tonne "t",
+	// gram "g" and g-force "g"
+	for _, unit := range []MagneticFluxDensityUnit{MagneticFluxDensity.Tesla, MagneticFluxDensity.Gauss} {
+		data, err := MarshalWithFormat(NewMagneticFluxDensity(2, unit), FormatFull)
+		if err != nil {
+			t.Fatalf("MarshalWithFormat error: %v", err)
+		}
+		am, err := UnmarshalMeasurement(data)
+		if err != nil {
+			t.Fatalf("UnmarshalMeasurement(%s) error: %v", data, err)
+		}
+		got, ok := am.AsMagneticFluxDensity()
+		if !ok || got.Value != 2 || !got.Unit.Equals(unit) {
+			t.Errorf("round trip of %s = %v, %v", data, got, ok)
+		}
+	}
+
+	if m, err := ParseMass("2 t"); err != nil || !m.Unit.Equals(Mass.MetricTon) {
+		t.Errorf("ParseMass(\"2 t\") = %v, %v", m, err)
+	}
+	if m, err := ParseMass("2 g"); err != nil || !m.Unit.Equals(Mass.Gram) {
+		t.Errorf("ParseMass(\"2 g\") = %v, %v", m, err)
+	}
+	if a, err := ParseAcceleration("2 g"); err != nil || a.Unit.Dimension() != "acceleration" {
+		t.Errorf("ParseAcceleration(\"2 g\") = %v, %v", a, err)
+	}
+}
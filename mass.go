@@ -2,6 +2,11 @@
 // physical quantities with units.
 package unit
 
+import (
+	"math"
+	"strconv"
+)
+
 // MassUnit represents a unit of mass
 type MassUnit struct {
 	BaseUnit
@@ -18,6 +23,7 @@ var Mass = struct {
 	Stone     MassUnit
 	MetricTon MassUnit
 	Ton       MassUnit
+	LongTon   MassUnit
 }{
 	Kilogram: MassUnit{
 		BaseUnit: NewBaseUnit(
@@ -109,9 +115,53 @@ var Mass = struct {
 			false,
 		),
 	},
+	LongTon: MassUnit{
+		BaseUnit: NewBaseUnit(
+			"mass",
+			"long ton",
+			"Long Ton",
+			1016.0469088, // 1 long ton = 2240 lb = 1016.0469088 kg (imperial ton)
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewMass creates a new mass quantity
 func NewMass(value float64, unit MassUnit) Quantity[MassUnit] {
 	return New(value, unit)
 }
+
+// MassToEnergy returns the rest energy E = m·c² of mass m. This is a physical
+// relation between two dimensions, not a unit conversion. The result is
+// always in the base energy unit (J); use ConvertTo for other units.
+func MassToEnergy(m Quantity[MassUnit]) Quantity[EnergyUnit] {
+	c := Constants.SpeedOfLight.Value
+	kg := m.Unit.ConvertToBaseUnit(m.Value)
+	return NewEnergy(kg*c*c, Energy.Joule)
+}
+
+// EnergyToMass returns the mass m = E/c² equivalent to energy e, the inverse
+// of MassToEnergy. The result is always in the base mass unit (kg).
+func EnergyToMass(e Quantity[EnergyUnit]) Quantity[MassUnit] {
+	c := Constants.SpeedOfLight.Value
+	joules := e.Unit.ConvertToBaseUnit(e.Value)
+	return NewMass(joules/(c*c), Mass.Kilogram)
+}
+
+// StonePoundsString formats m as stone and whole pounds, the UK convention
+// for body weight, e.g. "11 st 5 lb" for 72 kg. Pounds are rounded to the
+// nearest whole pound; see ParseMassStonePounds for the inverse.
+func StonePoundsString(m Quantity[MassUnit]) string {
+	pounds := math.Round(m.ConvertTo(Mass.Pound).Value)
+	sign := ""
+	if pounds < 0 {
+		sign = "-"
+		pounds = -pounds
+	}
+
+	poundsPerStone := math.Round(Mass.Stone.ConvertToBaseUnit(1) / Mass.Pound.ConvertToBaseUnit(1))
+	stones := math.Floor(pounds / poundsPerStone)
+	pounds -= stones * poundsPerStone
+	return sign + strconv.FormatFloat(stones, 'f', 0, 64) + " st " + strconv.FormatFloat(pounds, 'f', 0, 64) + " lb"
+}
@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"errors"
 	"math"
 	"testing"
 )
@@ -126,3 +127,130 @@ func TestMassSerialization(t *testing.T) {
 		t.Errorf("Round-trip serialization failed: got %v, expected %v", mass2, mass)
 	}
 }
+
+func TestMassEnergyEquivalence(t *testing.T) {
+	energy := MassToEnergy(NewMass(1, Mass.Kilogram))
+	if !energy.Unit.Equals(Energy.Joule) {
+		t.Errorf("MassToEnergy unit = %s, expected J", energy.Unit.Symbol())
+	}
+	if math.Abs(energy.Value-8.987551787368176e16)/8.987551787368176e16 > 1e-12 {
+		t.Errorf("MassToEnergy(1 kg) = %v, expected ≈8.987e16 J", energy)
+	}
+
+	// Non-base input units are converted first
+	energy = MassToEnergy(NewMass(1, Mass.Gram))
+	if math.Abs(energy.Value-8.987551787368176e13)/8.987551787368176e13 > 1e-12 {
+		t.Errorf("MassToEnergy(1 g) = %v, expected ≈8.987e13 J", energy)
+	}
+
+	mass := EnergyToMass(MassToEnergy(NewMass(2.5, Mass.Kilogram)))
+	if math.Abs(mass.Value-2.5) > 1e-12 || !mass.Unit.Equals(Mass.Kilogram) {
+		t.Errorf("EnergyToMass round trip = %v, expected 2.5 kg", mass)
+	}
+}
+
+func TestMassTonVariants(t *testing.T) {
+	tons := []struct {
+		unit MassUnit
+		kg   float64
+	}{
+		{Mass.MetricTon, 1000},
+		{Mass.Ton, 907.18474},
+		{Mass.LongTon, 1016.0469088},
+	}
+	for i, a := range tons {
+		if got := NewMass(1, a.unit).ConvertTo(Mass.Kilogram).Value; math.Abs(got-a.kg) > 1e-9 {
+			t.Errorf("1 %s = %g kg, expected %g", a.unit.Symbol(), got, a.kg)
+		}
+		for _, b := range tons[i+1:] {
+			if a.unit.Equals(b.unit) || a.unit.Symbol() == b.unit.Symbol() || CanonicalKey(a.unit) == CanonicalKey(b.unit) {
+				t.Errorf("%s and %s are not distinct", a.unit.Name(), b.unit.Name())
+			}
+		}
+	}
+
+	// A long ton is 2240 lb, a short ton 2000 lb
+	if got := NewMass(1, Mass.LongTon).ConvertTo(Mass.Pound).Value; math.Abs(got-2240) > 1e-9 {
+		t.Errorf("1 long ton = %g lb, expected 2240", got)
+	}
+
+	parsed := []struct {
+		input string
+		unit  MassUnit
+	}{
+		{"1 t", Mass.MetricTon},
+		{"1 tonne", Mass.MetricTon},
+		{"2 tonnes", Mass.MetricTon},
+		{"1 metric ton", Mass.MetricTon},
+		{"1 ton", Mass.Ton},
+		{"1 short ton", Mass.Ton},
+		{"1 long ton", Mass.LongTon},
+		{"3 long tons", Mass.LongTon},
+		{"1 imperial ton", Mass.LongTon},
+	}
+	for _, tc := range parsed {
+		q, err := ParseMass(tc.input)
+		if err != nil {
+			t.Errorf("ParseMass(%q) error: %v", tc.input, err)
+			continue
+		}
+		if !q.Unit.Equals(tc.unit) {
+			t.Errorf("ParseMass(%q) unit = %s, expected %s", tc.input, q.Unit.Name(), tc.unit.Name())
+		}
+	}
+
+	for _, key := range []string{"mass_metric_ton", "mass_ton", "mass_long_ton"} {
+		q, err := UnmarshalMass([]byte(`{"value":1,"unit":"` + key + `"}`))
+		if err != nil {
+			t.Errorf("UnmarshalMass(%s) error: %v", key, err)
+			continue
+		}
+		if CanonicalKey(q.Unit) != key {
+			t.Errorf("UnmarshalMass(%s) = %s", key, CanonicalKey(q.Unit))
+		}
+	}
+}
+
+func TestStonePounds(t *testing.T) {
+	formatTests := []struct {
+		mass     Quantity[MassUnit]
+		expected string
+	}{
+		{NewMass(72, Mass.Kilogram), "11 st 5 lb"},
+		{NewMass(158, Mass.Pound), "11 st 4 lb"},
+		{NewMass(12, Mass.Stone), "12 st 0 lb"},
+		{NewMass(13.6, Mass.Pound), "1 st 0 lb"}, // rounding carries into stone
+		{NewMass(3, Mass.Kilogram), "0 st 7 lb"},
+	}
+	for _, tc := range formatTests {
+		if got := StonePoundsString(tc.mass); got != tc.expected {
+			t.Errorf("StonePoundsString(%v) = %q, expected %q", tc.mass, got, tc.expected)
+		}
+	}
+
+	parseTests := []struct {
+		input string
+		kg    float64
+	}{
+		{"11 st 4 lb", 71.667594},
+		{"11st 4lb", 71.667594},
+		{"11 stone 4 pounds", 71.667594},
+		{"12 st", 76.203518},
+		{"4.5 lb", 2.041166},
+	}
+	for _, tc := range parseTests {
+		got, err := ParseMassStonePounds(tc.input)
+		if err != nil {
+			t.Fatalf("ParseMassStonePounds(%q) error: %v", tc.input, err)
+		}
+		if !got.Unit.Equals(Mass.Kilogram) || math.Abs(got.Value-tc.kg) > 1e-5 {
+			t.Errorf("ParseMassStonePounds(%q) = %v, expected %g kg", tc.input, got, tc.kg)
+		}
+	}
+
+	for _, input := range []string{"", "11 kg", "st lb", "4 lb 11 st"} {
+		if _, err := ParseMassStonePounds(input); !errors.Is(err, ErrMalformedValue) {
+			t.Errorf("ParseMassStonePounds(%q) error = %v, expected ErrMalformedValue", input, err)
+		}
+	}
+}
@@ -9,9 +9,10 @@ type PowerUnit struct {
 
 // Power contains predefined power units
 var Power = struct {
-	Watt       PowerUnit
-	Kilowatt   PowerUnit
-	BTUPerHour PowerUnit
+	Watt             PowerUnit
+	Kilowatt         PowerUnit
+	BTUPerHour       PowerUnit
+	TonRefrigeration PowerUnit
 }{
 	Watt: PowerUnit{
 		BaseUnit: NewBaseUnit(
@@ -43,6 +44,16 @@ var Power = struct {
 			false,
 		),
 	},
+	TonRefrigeration: PowerUnit{
+		BaseUnit: NewBaseUnit(
+			"power",
+			"RT",
+			"Ton of Refrigeration",
+			3516.8528420667, // 1 RT = 12,000 BTU/h ≈ 3516.853 W
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewPower creates a new power quantity
@@ -0,0 +1,33 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTonRefrigeration(t *testing.T) {
+	rt := NewPower(1, Power.TonRefrigeration)
+	if got := rt.ConvertTo(Power.Kilowatt).Value; math.Abs(got-3.517) > 0.001 {
+		t.Errorf("1 RT = %g kW, expected ≈3.517", got)
+	}
+	// A ton of refrigeration is defined as 12,000 BTU/h
+	if got := rt.ConvertTo(Power.BTUPerHour).Value; math.Abs(got-12000) > 0.01 {
+		t.Errorf("1 RT = %g BTU/h, expected ≈12000", got)
+	}
+
+	for _, format := range []SerializationFormat{FormatFull, FormatCompact, FormatMinimal} {
+		data, err := MarshalWithFormat(NewPower(5, Power.TonRefrigeration), format)
+		if err != nil {
+			t.Fatalf("MarshalWithFormat(%v) error: %v", format, err)
+		}
+		got, err := UnmarshalPower(data)
+		if err != nil || got.Value != 5 || !got.Unit.Equals(Power.TonRefrigeration) {
+			t.Errorf("UnmarshalPower(%s) = %v, %v", data, got, err)
+		}
+	}
+
+	q, err := ParseQuantity[PowerUnit]("3 RT")
+	if err != nil || !q.Unit.Equals(Power.TonRefrigeration) {
+		t.Errorf("ParseQuantity(\"3 RT\") = %v, %v", q, err)
+	}
+}
@@ -2,6 +2,8 @@
 // physical quantities with units.
 package unit
 
+import "math"
+
 // PressureUnit represents a unit of pressure
 type PressureUnit struct {
 	BaseUnit
@@ -14,6 +16,7 @@ var Pressure = struct {
 	Bar        PressureUnit
 	PSI        PressureUnit
 	InchH2O    PressureUnit
+	InchHg     PressureUnit
 }{
 	Pascal: PressureUnit{
 		BaseUnit: NewBaseUnit(
@@ -65,9 +68,32 @@ var Pressure = struct {
 			false,
 		),
 	},
+	InchHg: PressureUnit{
+		BaseUnit: NewBaseUnit(
+			"pressure",
+			"inHg",
+			"Inches of Mercury",
+			3386.389, // 1 inHg = 3,386.389 Pa
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewPressure creates a new pressure quantity
 func NewPressure(value float64, unit PressureUnit) Quantity[PressureUnit] {
 	return New(value, unit)
 }
+
+// PressureAltitude estimates the altitude at which the pressure p is observed,
+// given the pressure at sea level, using the international standard atmosphere
+// barometric formula h = 44330.8 m · (1 - (p/p₀)^0.190263). The result is in meters.
+func PressureAltitude(p Quantity[PressureUnit], seaLevel Quantity[PressureUnit]) Quantity[LengthUnit] {
+	const (
+		scaleHeight = 44330.8  // T₀/L = 288.15 K / 0.0065 K/m
+		exponent    = 0.190263 // R·L/(g·M)
+	)
+	pa := p.Unit.ConvertToBaseUnit(p.Value)
+	seaLevelPa := seaLevel.Unit.ConvertToBaseUnit(seaLevel.Value)
+	return NewLength(scaleHeight*(1-math.Pow(pa/seaLevelPa, exponent)), Length.Meter)
+}
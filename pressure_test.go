@@ -0,0 +1,116 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPressureAltitude(t *testing.T) {
+	seaLevel := NewPressure(101.325, Pressure.Kilopascal)
+
+	testCases := []struct {
+		name      string
+		pressure  Quantity[PressureUnit]
+		expected  float64
+		tolerance float64
+	}{
+		{"Sea level", NewPressure(101.325, Pressure.Kilopascal), 0, 1e-9},
+		{"900 hPa", NewPressure(90000, Pressure.Pascal), 1000, 15},
+		{"500 hPa", NewPressure(0.5, Pressure.Bar), 5574, 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			altitude := PressureAltitude(tc.pressure, seaLevel)
+			if !altitude.Unit.Equals(Length.Meter) {
+				t.Errorf("Expected altitude in meters, got %s", altitude.Unit.Symbol())
+			}
+			if math.Abs(altitude.Value-tc.expected) > tc.tolerance {
+				t.Errorf("PressureAltitude(%v) = %g m, expected %g m", tc.pressure, altitude.Value, tc.expected)
+			}
+		})
+	}
+}
+
+func TestInchH2OSubscript(t *testing.T) {
+	for _, input := range []string{"10 inH2O", "10 inH₂O", "10inH2O"} {
+		p, err := ParsePressure(input)
+		if err != nil {
+			t.Fatalf("ParsePressure(%q): %v", input, err)
+		}
+		if p.Value != 10 || !p.Unit.Equals(Pressure.InchH2O) || p.Unit.Symbol() != "inH₂O" {
+			t.Errorf("ParsePressure(%q) = %v, expected 10 inH₂O", input, p)
+		}
+	}
+
+	original := NewPressure(10, Pressure.InchH2O)
+	for _, format := range []SerializationFormat{FormatFull, FormatCompact, FormatMinimal, FormatCompactWithDimension} {
+		for _, marshal := range []func(Quantity[PressureUnit], SerializationFormat) ([]byte, error){
+			MarshalWithFormat[PressureUnit], MarshalWithFormatASCII[PressureUnit],
+		} {
+			data, err := marshal(original, format)
+			if err != nil {
+				t.Fatalf("Marshal format %d: %v", format, err)
+			}
+			m, err := UnmarshalMeasurement(data)
+			if err != nil {
+				t.Fatalf("UnmarshalMeasurement(%s): %v", data, err)
+			}
+			p, ok := m.AsPressure()
+			if !ok || p.Value != 10 || p.Unit.Symbol() != "inH₂O" {
+				t.Errorf("Round trip of %s = %v, expected 10 inH₂O", data, p)
+			}
+		}
+	}
+
+	// A payload carrying the subscript symbol directly
+	p, err := UnmarshalPressure([]byte(`{"value":10,"unit":{"name":"Inches of Water Column","symbol":"inH₂O","dimension":"pressure"}}`))
+	if err != nil || !p.Unit.Equals(Pressure.InchH2O) {
+		t.Errorf("UnmarshalPressure = %v, %v; expected 10 inH₂O", p, err)
+	}
+}
+
+func TestPressureMinimalKeys(t *testing.T) {
+	for _, u := range registeredUnits("pressure") {
+		data, err := MarshalWithFormat(Quantity[Category]{Value: 3, Unit: u}, FormatMinimal)
+		if err != nil {
+			t.Fatalf("Marshal %s: %v", u.Symbol(), err)
+		}
+		p, err := UnmarshalPressure(data)
+		if err != nil || !p.Unit.Equals(u) {
+			t.Errorf("UnmarshalPressure(%s) = %v, %v; expected 3 %s", data, p, err, u.Symbol())
+		}
+	}
+}
+
+func TestInchHg(t *testing.T) {
+	// Standard altimeter setting: 29.92 inHg ≈ 1013.25 hPa
+	altimeter := NewPressure(29.92, Pressure.InchHg)
+	if got := altimeter.ConvertTo(Pressure.Pascal).Value; math.Abs(got-101325) > 10 {
+		t.Errorf("29.92 inHg = %g Pa, expected ≈101325", got)
+	}
+	if got := NewPressure(101.325, Pressure.Kilopascal).ConvertTo(Pressure.InchHg).Value; math.Abs(got-29.921) > 0.001 {
+		t.Errorf("101.325 kPa = %g inHg, expected ≈29.921", got)
+	}
+
+	for _, input := range []string{"29.92 inHg", "29.92inHg", "29.92 INHG"} {
+		p, err := ParsePressure(input)
+		if err != nil || p.Value != 29.92 || !p.Unit.Equals(Pressure.InchHg) {
+			t.Errorf("ParsePressure(%q) = %v, %v", input, p, err)
+		}
+	}
+	if p, err := ParsePressure("10 inH2O"); err != nil || !p.Unit.Equals(Pressure.InchH2O) {
+		t.Errorf("ParsePressure(\"10 inH2O\") = %v, %v, expected inH₂O", p, err)
+	}
+
+	for _, format := range []SerializationFormat{FormatFull, FormatCompact, FormatMinimal, FormatCompactWithDimension} {
+		data, err := MarshalWithFormat(altimeter, format)
+		if err != nil {
+			t.Fatalf("Marshal format %d: %v", format, err)
+		}
+		p, err := UnmarshalPressure(data)
+		if err != nil || p.Value != 29.92 || !p.Unit.Equals(Pressure.InchHg) {
+			t.Errorf("UnmarshalPressure(%s) = %v, %v", data, p, err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 )
 
 // Category is an interface that all unit types must implement
@@ -22,6 +23,10 @@ type Category interface {
 	// IsBaseUnit returns true if this is the base unit for its dimension
 	IsBaseUnit() bool
 
+	// IsAffine returns true if the unit's zero is not a physical zero (e.g. °C),
+	// so scaling or summing its values is not meaningful
+	IsAffine() bool
+
 	// ConvertToBaseUnit converts a value in this unit to the base unit
 	ConvertToBaseUnit(value float64) float64
 
@@ -39,7 +44,19 @@ type Quantity[T Category] struct {
 	Unit  T
 }
 
-// Equal checks if two quantities are equal
+// Dimension returns the dimension of the quantity's unit, e.g. "length"
+func (m Quantity[T]) Dimension() string {
+	return m.Unit.Dimension()
+}
+
+// IsSameUnit reports whether m and other are expressed in the same unit,
+// regardless of their values
+func (m Quantity[T]) IsSameUnit(other Quantity[T]) bool {
+	return m.Unit.Equals(other.Unit)
+}
+
+// Equal checks if two quantities are equal within the tolerances set by
+// SetComparisonEpsilon, comparing them in base units
 func (m Quantity[T]) Equal(other Quantity[T]) bool {
 	// Check if the dimensions are compatible
 	if m.Unit.Dimension() != other.Unit.Dimension() {
@@ -50,9 +67,55 @@ func (m Quantity[T]) Equal(other Quantity[T]) bool {
 	mBaseValue := m.Unit.ConvertToBaseUnit(m.Value)
 	otherBaseValue := other.Unit.ConvertToBaseUnit(other.Value)
 
-	// Use a small epsilon for floating point comparison
-	const epsilon = 1e-9
-	return math.Abs(mBaseValue-otherBaseValue) < epsilon
+	// Tolerances are set with SetComparisonEpsilon
+	return withinEpsilon(mBaseValue, otherBaseValue)
+}
+
+// EqualString parses s as a quantity of the same unit type and checks whether it
+// is equal to m, e.g. NewLength(1, Length.Kilometer).EqualString("1000 m")
+func (m Quantity[T]) EqualString(s string) (bool, error) {
+	other, err := ParseQuantity[T](s)
+	if err != nil {
+		return false, err
+	}
+	return m.Equal(other), nil
+}
+
+// Between reports whether low <= m <= high, comparing all three in base units
+// so the bounds may use different units than m. A value equal to a bound
+// within the tolerances set by SetComparisonEpsilon counts as inside. It
+// panics if low is greater than high.
+func (m Quantity[T]) Between(low, high Quantity[T]) bool {
+	lowBase := low.Unit.ConvertToBaseUnit(low.Value)
+	highBase := high.Unit.ConvertToBaseUnit(high.Value)
+	if lowBase > highBase {
+		panic("Lower bound must not be greater than upper bound")
+	}
+
+	return withinBounds(m.Unit.ConvertToBaseUnit(m.Value), lowBase, highBase)
+}
+
+// RelativeError returns the relative error of m, a measured value, against
+// reference: (m − reference) / reference, computed in base units so the two
+// may use different units. It panics if the dimensions differ or reference
+// is zero.
+func (m Quantity[T]) RelativeError(reference Quantity[T]) float64 {
+	if m.Unit.Dimension() != reference.Unit.Dimension() {
+		panic(fmt.Sprintf("Cannot compare %s with %s: incompatible dimensions",
+			m.Unit.Dimension(), reference.Unit.Dimension()))
+	}
+
+	referenceBase := reference.Unit.ConvertToBaseUnit(reference.Value)
+	if referenceBase == 0 {
+		panic("Cannot compute relative error against a zero reference")
+	}
+	return (m.Unit.ConvertToBaseUnit(m.Value) - referenceBase) / referenceBase
+}
+
+// PercentError returns RelativeError as a percentage, e.g. 2 for 102 m
+// against 100 m
+func (m Quantity[T]) PercentError(reference Quantity[T]) float64 {
+	return m.RelativeError(reference) * 100
 }
 
 // New creates a new quantity with the given value and unit
@@ -63,8 +126,24 @@ func New[T Category](value float64, unit T) Quantity[T] {
 	}
 }
 
+// FromBaseValue creates a quantity in unit from a value expressed in the base
+// unit of its dimension, e.g. FromBaseValue(1000, Length.Kilometer) is 1 km.
+// It is the inverse of AnyMeasurement.BaseValue; note that the base unit of
+// temperature is °C.
+func FromBaseValue[T Category](baseValue float64, unit T) Quantity[T] {
+	return New(unit.ConvertFromBaseUnit(baseValue), unit)
+}
+
 // ConvertTo converts this quantity to the specified unit
 func (m Quantity[T]) ConvertTo(unit T) Quantity[T] {
+	// Use the cached composed transform for linear units
+	if f, ok := cachedConversion(m.Unit, unit); ok {
+		return Quantity[T]{
+			Value: m.Value*f.scale + f.offset,
+			Unit:  unit,
+		}
+	}
+
 	// If the units are the same, return a copy of the quantity
 	if m.Unit.Equals(unit) {
 		return Quantity[T]{
@@ -79,7 +158,11 @@ func (m Quantity[T]) ConvertTo(unit T) Quantity[T] {
 			m.Unit.Dimension(), unit.Dimension()))
 	}
 
-	// Convert to base unit first, then to the target unit
+	return m.convertViaBase(unit)
+}
+
+// convertViaBase converts to the base unit first, then to the target unit
+func (m Quantity[T]) convertViaBase(unit T) Quantity[T] {
 	valueInBaseUnit := m.Unit.ConvertToBaseUnit(m.Value)
 	valueInTargetUnit := unit.ConvertFromBaseUnit(valueInBaseUnit)
 
@@ -125,6 +208,44 @@ func (m Quantity[T]) Subtract(other Quantity[T]) Quantity[T] {
 	}
 }
 
+// AddBase adds baseDelta, a difference expressed in the base unit of m's
+// dimension, and returns the result in m's unit, e.g.
+// NewLength(1, Length.Kilometer).AddBase(500) is 1.5 km. The delta is applied
+// in base units, so affine offsets cancel: 50 °F AddBase(10) is 68 °F.
+func (m Quantity[T]) AddBase(baseDelta float64) Quantity[T] {
+	return FromBaseValue(m.Unit.ConvertToBaseUnit(m.Value)+baseDelta, m.Unit)
+}
+
+// SubtractBase subtracts baseDelta, a difference expressed in the base unit of
+// m's dimension, and returns the result in m's unit
+func (m Quantity[T]) SubtractBase(baseDelta float64) Quantity[T] {
+	return m.AddBase(-baseDelta)
+}
+
+// AddPreferFiner adds other to m and returns the sum in whichever operand's
+// unit is finer (has the smaller base-unit factor), so 1 km + 1 mm is
+// 1000001 mm rather than 1.000001 km. On a tie the receiver's unit is kept.
+// A reciprocal unit such as L/100km has no factor and is never finer: the sum
+// is in the other operand's unit, or the receiver's if both are reciprocal.
+func (m Quantity[T]) AddPreferFiner(other Quantity[T]) Quantity[T] {
+	unit := CommonUnit([]Quantity[T]{m, other})
+	return m.ConvertTo(unit).Add(other)
+}
+
+// AddChecked is like Add but returns an error instead of a meaningless sum
+// when either quantity is in an affine unit (e.g. 20 °C + 20 °C)
+func (m Quantity[T]) AddChecked(other Quantity[T]) (Quantity[T], error) {
+	if m.Unit.IsAffine() || other.Unit.IsAffine() {
+		return Quantity[T]{}, fmt.Errorf("cannot add %s and %s: affine units have no absolute zero",
+			m.Unit.Symbol(), other.Unit.Symbol())
+	}
+	if m.Unit.Dimension() != other.Unit.Dimension() {
+		return Quantity[T]{}, fmt.Errorf("cannot add %s and %s: incompatible dimensions",
+			m.Unit.Dimension(), other.Unit.Dimension())
+	}
+	return m.Add(other), nil
+}
+
 // MultiplyByScalar multiplies this quantity by a scalar value
 func (m Quantity[T]) MultiplyByScalar(scalar float64) Quantity[T] {
 	return Quantity[T]{
@@ -133,6 +254,16 @@ func (m Quantity[T]) MultiplyByScalar(scalar float64) Quantity[T] {
 	}
 }
 
+// MultiplyByScalarChecked is like MultiplyByScalar but returns an error when
+// the quantity is in an affine unit, where scaling depends on the arbitrary
+// zero point (2 × 10 °C is not twice as warm)
+func (m Quantity[T]) MultiplyByScalarChecked(scalar float64) (Quantity[T], error) {
+	if m.Unit.IsAffine() {
+		return Quantity[T]{}, fmt.Errorf("cannot scale %s: affine units have no absolute zero", m.Unit.Symbol())
+	}
+	return m.MultiplyByScalar(scalar), nil
+}
+
 // DivideByScalar divides this quantity by a scalar value
 func (m Quantity[T]) DivideByScalar(scalar float64) Quantity[T] {
 	if scalar == 0 {
@@ -145,9 +276,201 @@ func (m Quantity[T]) DivideByScalar(scalar float64) Quantity[T] {
 	}
 }
 
+// DivideInto splits this quantity into n equal parts in the same unit, e.g.
+// 12 L into 4 gives four 3 L quantities. It returns an error if n is not
+// positive.
+func (m Quantity[T]) DivideInto(n int) ([]Quantity[T], error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("cannot divide into %d parts", n)
+	}
+
+	parts := make([]Quantity[T], n)
+	for i := range parts {
+		parts[i] = m.DivideByScalar(float64(n))
+	}
+	return parts, nil
+}
+
+// PerUnit returns the base-unit value of m divided by the duration d in seconds,
+// e.g. 100 m over 10 s gives 10. The result is a raw float in base units per
+// second, not a typed quantity of a new dimension. It panics if d is zero.
+func (m Quantity[T]) PerUnit(d Quantity[DurationUnit]) float64 {
+	seconds := d.Unit.ConvertToBaseUnit(d.Value)
+	if seconds == 0 {
+		panic("Cannot divide by zero duration")
+	}
+	return m.Unit.ConvertToBaseUnit(m.Value) / seconds
+}
+
+// niceMantissas are the significands RoundToNiceStep rounds to, in ascending order
+var niceMantissas = []float64{1, 2, 2.5, 5, 10}
+
+// RoundToNiceStep rounds the value of m, in its current unit, to the nearest
+// "nice" number 1, 2, 2.5 or 5 × 10ⁿ, e.g. for choosing chart gridline steps.
+// The magnitude is written as a significand in [1, 10) times a power of ten
+// and the significand is replaced by the nearest of 1, 2, 2.5, 5 and 10,
+// measured linearly, with ties going to the larger; the sign is kept. So 0.037
+// becomes 0.025, 73 becomes 50 and 8400 becomes 10000. Zero, NaN and infinite
+// values are returned unchanged.
+func (m Quantity[T]) RoundToNiceStep() Quantity[T] {
+	magnitude := math.Abs(m.Value)
+	if magnitude == 0 || math.IsNaN(magnitude) || math.IsInf(magnitude, 0) {
+		return m
+	}
+
+	exponent := int(math.Floor(math.Log10(magnitude)))
+	significand := magnitude / math.Pow10(exponent)
+	nice := niceMantissas[0]
+	for _, candidate := range niceMantissas[1:] {
+		if math.Abs(candidate-significand) <= math.Abs(nice-significand) {
+			nice = candidate
+		}
+	}
+
+	// Divide for negative exponents so e.g. 2.5/100 is exactly 0.025
+	value := nice * math.Pow10(exponent)
+	if exponent < 0 {
+		value = nice / math.Pow10(-exponent)
+	}
+	return Quantity[T]{
+		Value: math.Copysign(value, m.Value),
+		Unit:  m.Unit,
+	}
+}
+
+// WeightedAverage returns Σ(value_i·w_i)/Σw_i computed in base units and
+// expressed in the unit of the first quantity
+func WeightedAverage[T Category](quantities []Quantity[T], weights []float64) (Quantity[T], error) {
+	if len(quantities) == 0 {
+		return Quantity[T]{}, fmt.Errorf("cannot average an empty list of quantities")
+	}
+	if len(quantities) != len(weights) {
+		return Quantity[T]{}, fmt.Errorf("got %d quantities but %d weights", len(quantities), len(weights))
+	}
+
+	var weightedSum, weightSum float64
+	for i, q := range quantities {
+		weightedSum += q.Unit.ConvertToBaseUnit(q.Value) * weights[i]
+		weightSum += weights[i]
+	}
+	if weightSum == 0 {
+		return Quantity[T]{}, fmt.Errorf("sum of weights must not be zero")
+	}
+
+	unit := quantities[0].Unit
+	return Quantity[T]{
+		Value: unit.ConvertFromBaseUnit(weightedSum / weightSum),
+		Unit:  unit,
+	}, nil
+}
+
+// CommonUnit returns the finest unit (the one with the smallest base-unit
+// factor) present in qs, so that all of them can be shown in a single column
+// without losing precision. Units without a linear factor, such as L/100km,
+// are only chosen if no unit in qs has one, in which case the first unit is
+// returned. It returns the zero unit for an empty slice.
+func CommonUnit[T Category](qs []Quantity[T]) T {
+	var finest T
+	if len(qs) > 0 {
+		finest = qs[0].Unit
+	}
+	finestFactor := math.Inf(1)
+	for _, q := range qs {
+		scale, _, ok := unitFactors(q.Unit)
+		if !ok {
+			continue
+		}
+		if factor := math.Abs(scale); factor < finestFactor {
+			finest, finestFactor = q.Unit, factor
+		}
+	}
+	return finest
+}
+
+// ConvertAll converts every quantity in qs to unit and returns them as a new slice
+func ConvertAll[T Category](qs []Quantity[T], unit T) []Quantity[T] {
+	result := make([]Quantity[T], len(qs))
+	for i, q := range qs {
+		result[i] = q.ConvertTo(unit)
+	}
+	return result
+}
+
+// Zero returns a zero quantity in the base unit of T's dimension (e.g. 0 m
+// for LengthUnit), usable as the seed of a sum. For affine dimensions the
+// base unit decides what zero means: Zero[TemperatureUnit]() is 0 °C, not
+// absolute zero. Unit types without a registered dimension get the zero unit.
+func Zero[T Category]() Quantity[T] {
+	var unit T
+	dimension := dimensionOf[T]()
+	if dimension == "general" {
+		unit, _ = any(General.Unit).(T)
+	} else if r, ok := registries[dimension]; ok {
+		unit, _ = r.base.(T)
+	}
+	return Quantity[T]{Value: 0, Unit: unit}
+}
+
+// erased returns the quantity with its unit type widened to Category
+func (m Quantity[T]) erased() Quantity[Category] {
+	return Quantity[Category]{Value: m.Value, Unit: m.Unit}
+}
+
 // String returns a string representation of the quantity
 func (m Quantity[T]) String() string {
-	return fmt.Sprintf("%g %s", m.Value, m.Unit.Symbol())
+	var buf [32]byte
+	return string(m.AppendString(buf[:0]))
+}
+
+// ExactString returns the quantity as the shortest decimal that parses back to
+// exactly the same float64, followed by the unit symbol, so that
+// ParseQuantity[T](m.ExactString()) equals m for every finite value. Very
+// large and small values use an exponent ("1.5e-09 m").
+func (m Quantity[T]) ExactString() string {
+	return strconv.FormatFloat(m.Value, 'g', -1, 64) + " " + m.Unit.Symbol()
+}
+
+// AppendString appends the string representation of the quantity (as returned
+// by String) to dst and returns the extended buffer, avoiding allocations on
+// hot logging paths
+func (m Quantity[T]) AppendString(dst []byte) []byte {
+	dst = strconv.AppendFloat(dst, m.Value, 'g', -1, 64)
+	dst = append(dst, ' ')
+	return append(dst, m.Unit.Symbol()...)
+}
+
+// ConvertAndFormat converts m to unit and formats it with the given number of
+// decimals, e.g. "1.00 mi". A negative decimals uses the shortest
+// representation, as String does.
+func (m Quantity[T]) ConvertAndFormat(unit T, decimals int) string {
+	converted := m.ConvertTo(unit)
+	if decimals < 0 {
+		return converted.String()
+	}
+	return strconv.FormatFloat(converted.Value, 'f', decimals, 64) + " " + unit.Symbol()
+}
+
+// Sign returns -1, 0, or +1 depending on the sign of the stored value.
+// For affine units (e.g. Celsius) the sign refers to the value in its own
+// unit, so -5 °C is negative even though it is above absolute zero.
+func (m Quantity[T]) Sign() int {
+	switch {
+	case m.Value > 0:
+		return 1
+	case m.Value < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// StringSigned returns a string representation of the quantity that always
+// carries an explicit sign for positive values (e.g. "+5 m"), useful for deltas
+func (m Quantity[T]) StringSigned() string {
+	if m.Sign() > 0 {
+		return "+" + m.String()
+	}
+	return m.String()
 }
 
 // MarshalJSON implements json.Marshaler interface
@@ -190,7 +513,7 @@ func (m *Quantity[T]) UnmarshalJSON(data []byte) error {
 	m.Value = raw.Value
 
 	// Look up unit by dimension and symbol
-	unit, err := lookupUnit[T](raw.Dimension, raw.Unit.Symbol)
+	unit, err := lookupUnit[T](raw.Dimension, fromASCIISymbol(raw.Unit.Symbol))
 	if err != nil {
 		return err
 	}
@@ -207,7 +530,7 @@ type Compact[T Category] struct {
 
 // MarshalJSON implements json.Marshaler for compact format
 func (c Compact[T]) MarshalJSON() ([]byte, error) {
-	key := toSnakeCase(c.Unit.Dimension()) + "_" + toSnakeCase(c.Unit.Name())
+	key := CanonicalKey(c.Unit)
 	return json.Marshal(struct {
 		Value  float64 `json:"value"`
 		Unit   string  `json:"unit"`
@@ -233,11 +556,14 @@ func (c *Compact[T]) UnmarshalJSON(data []byte) error {
 
 	c.Quantity.Value = raw.Value
 
-	// Parse compact unit key (e.g., "temperature_celsius")
-	dimension, unitName := parseCompactUnitKey(raw.Unit)
+	// Parse compact unit key (e.g., "temperature_celsius", "electric_charge_coulomb")
+	dimension, unitName := splitUnitKey(raw.Unit)
 
-	// Look up unit by dimension and unit name
+	// Look up unit by dimension and unit name, then by the optional symbol
 	unit, err := lookupUnitByName[T](dimension, unitName)
+	if err != nil && raw.Symbol != "" {
+		unit, err = lookupUnit[T](dimension, fromASCIISymbol(raw.Symbol))
+	}
 	if err != nil {
 		return err
 	}
@@ -246,129 +572,25 @@ func (c *Compact[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// parseCompactUnitKey splits "dimension_unit_name" into dimension and unit name
-func parseCompactUnitKey(key string) (dimension, unitName string) {
-	idx := indexOf(key, "_")
-	if idx == -1 {
-		return key, ""
-	}
-	return key[:idx], key[idx+1:]
-}
-
-// indexOf returns the index of the first occurrence of sep in s
-func indexOf(s, sep string) int {
-	for i := 0; i <= len(s)-len(sep); i++ {
-		if s[i:i+len(sep)] == sep {
-			return i
-		}
-	}
-	return -1
-}
-
 // lookupUnitByName finds a unit by dimension and snake_case name
 func lookupUnitByName[T Category](dimension, name string) (T, error) {
 	var zero T
 	var result any
 
-	key := dimension + "_" + name
-
-	switch dimension {
-	case "temperature":
-		if u, ok := temperatureUnitsByKey[key]; ok {
-			result = u
-		}
-	case "pressure":
-		if u, ok := pressureUnitsByKey[key]; ok {
-			result = u
-		}
-	case "flowrate":
-		if u, ok := flowRateUnitsByKey[key]; ok {
-			result = u
-		}
-	case "power":
-		if u, ok := powerUnitsByKey[key]; ok {
-			result = u
-		}
-	case "energy":
-		if u, ok := energyUnitsByKey[key]; ok {
-			result = u
-		}
-	case "length":
-		if u, ok := lengthUnitsByKey[key]; ok {
-			result = u
-		}
-	case "mass":
-		if u, ok := massUnitsByKey[key]; ok {
-			result = u
-		}
-	case "duration":
-		if u, ok := durationUnitsByKey[key]; ok {
-			result = u
-		}
-	case "angle":
-		if u, ok := angleUnitsByKey[key]; ok {
-			result = u
-		}
-	case "area":
-		if u, ok := areaUnitsByKey[key]; ok {
-			result = u
-		}
-	case "volume":
-		if u, ok := volumeUnitsByKey[key]; ok {
-			result = u
-		}
-	case "acceleration":
-		if u, ok := accelerationUnitsByKey[key]; ok {
-			result = u
-		}
-	case "concentration":
-		if u, ok := concentrationUnitsByKey[key]; ok {
-			result = u
-		}
-	case "dispersion":
-		if u, ok := dispersionUnitsByKey[key]; ok {
-			result = u
-		}
-	case "speed":
-		if u, ok := speedUnitsByKey[key]; ok {
-			result = u
-		}
-	case "electric_charge":
-		if u, ok := electricChargeUnitsByKey[key]; ok {
-			result = u
-		}
-	case "electric_current":
-		if u, ok := electricCurrentUnitsByKey[key]; ok {
-			result = u
-		}
-	case "electric_potential_difference":
-		if u, ok := electricPotentialDifferenceUnitsByKey[key]; ok {
-			result = u
-		}
-	case "frequency":
-		if u, ok := frequencyUnitsByKey[key]; ok {
-			result = u
-		}
-	case "illuminance":
-		if u, ok := illuminanceUnitsByKey[key]; ok {
-			result = u
-		}
-	case "information":
-		if u, ok := informationUnitsByKey[key]; ok {
-			result = u
+	if dimension == "general" {
+		result = NewGeneralUnit(name, name)
+	} else {
+		r, ok := registries[dimension]
+		if !ok {
+			return zero, fmt.Errorf("unknown dimension: %s", dimension)
 		}
-	case "fuel_efficiency":
-		if u, ok := fuelEfficiencyUnitsByKey[key]; ok {
+		if u, ok := r.byName[name]; ok {
 			result = u
 		}
-	case "general":
-		result = NewGeneralUnit(name, name)
-	default:
-		return zero, fmt.Errorf("unknown dimension: %s", dimension)
 	}
 
 	if result == nil {
-		return zero, fmt.Errorf("unknown unit key %q", key)
+		return zero, kindErrorf(ErrUnknownUnit, "unknown unit key %q", dimension+"_"+name)
 	}
 
 	if typed, ok := result.(T); ok {
@@ -378,108 +600,101 @@ func lookupUnitByName[T Category](dimension, name string) (T, error) {
 	return zero, fmt.Errorf("unit type mismatch: expected %T, got %T", zero, result)
 }
 
+// dimensionOf returns the dimension handled by the unit type T
+func dimensionOf[T Category]() string {
+	var zero T
+	switch any(zero).(type) {
+	case TemperatureUnit:
+		return "temperature"
+	case PressureUnit:
+		return "pressure"
+	case FlowRateUnit:
+		return "flowrate"
+	case PowerUnit:
+		return "power"
+	case EnergyUnit:
+		return "energy"
+	case LengthUnit:
+		return "length"
+	case MassUnit:
+		return "mass"
+	case DurationUnit:
+		return "duration"
+	case AngleUnit:
+		return "angle"
+	case AreaUnit:
+		return "area"
+	case VolumeUnit:
+		return "volume"
+	case AccelerationUnit:
+		return "acceleration"
+	case ConcentrationUnit:
+		return "concentration"
+	case DispersionUnit:
+		return "dispersion"
+	case SpeedUnit:
+		return "speed"
+	case ElectricChargeUnit:
+		return "electric_charge"
+	case ElectricCurrentUnit:
+		return "electric_current"
+	case ElectricPotentialDifferenceUnit:
+		return "electric_potential_difference"
+	case FrequencyUnit:
+		return "frequency"
+	case IlluminanceUnit:
+		return "illuminance"
+	case InformationUnit:
+		return "information"
+	case FuelEfficiencyUnit:
+		return "fuel_efficiency"
+	case RatioUnit:
+		return "ratio"
+	case DensityUnit:
+		return "density"
+	case ElectricFieldUnit:
+		return "electric_field"
+	case MagneticFluxDensityUnit:
+		return "magnetic_flux_density"
+	case AngularVelocityUnit:
+		return "angular_velocity"
+	case AreaDensityUnit:
+		return "area_density"
+	case LinearDensityUnit:
+		return "linear_density"
+	case HeatCapacityUnit:
+		return "heat_capacity"
+	case SpecificHeatCapacityUnit:
+		return "specific_heat_capacity"
+	case GeneralUnit:
+		return "general"
+	}
+	return ""
+}
+
 // lookupUnit finds a unit by dimension and symbol
 func lookupUnit[T Category](dimension, symbol string) (T, error) {
 	var zero T
 	var result any
 
-	switch dimension {
-	case "temperature":
-		if u, ok := LookupTemperatureUnit(symbol); ok {
-			result = u
-		}
-	case "pressure":
-		if u, ok := LookupPressureUnit(symbol); ok {
-			result = u
-		}
-	case "flowrate":
-		if u, ok := LookupFlowRateUnit(symbol); ok {
-			result = u
-		}
-	case "power":
-		if u, ok := LookupPowerUnit(symbol); ok {
-			result = u
-		}
-	case "energy":
-		if u, ok := LookupEnergyUnit(symbol); ok {
-			result = u
-		}
-	case "length":
-		if u, ok := LookupLengthUnit(symbol); ok {
-			result = u
-		}
-	case "mass":
-		if u, ok := LookupMassUnit(symbol); ok {
-			result = u
-		}
-	case "duration":
-		if u, ok := LookupDurationUnit(symbol); ok {
-			result = u
-		}
-	case "angle":
-		if u, ok := LookupAngleUnit(symbol); ok {
-			result = u
-		}
-	case "area":
-		if u, ok := LookupAreaUnit(symbol); ok {
-			result = u
-		}
-	case "volume":
-		if u, ok := LookupVolumeUnit(symbol); ok {
-			result = u
-		}
-	case "acceleration":
-		if u, ok := LookupAccelerationUnit(symbol); ok {
-			result = u
-		}
-	case "concentration":
-		if u, ok := LookupConcentrationUnit(symbol); ok {
-			result = u
-		}
-	case "dispersion":
-		if u, ok := LookupDispersionUnit(symbol); ok {
-			result = u
-		}
-	case "speed":
-		if u, ok := LookupSpeedUnit(symbol); ok {
-			result = u
-		}
-	case "electric_charge":
-		if u, ok := LookupElectricChargeUnit(symbol); ok {
-			result = u
-		}
-	case "electric_current":
-		if u, ok := LookupElectricCurrentUnit(symbol); ok {
-			result = u
-		}
-	case "electric_potential_difference":
-		if u, ok := LookupElectricPotentialDifferenceUnit(symbol); ok {
-			result = u
-		}
-	case "frequency":
-		if u, ok := LookupFrequencyUnit(symbol); ok {
-			result = u
-		}
-	case "illuminance":
-		if u, ok := LookupIlluminanceUnit(symbol); ok {
-			result = u
+	if dimension == "general" {
+		result = NewGeneralUnit(symbol, symbol)
+	} else {
+		r, ok := registries[dimension]
+		if !ok {
+			return zero, fmt.Errorf("unknown dimension: %s", dimension)
 		}
-	case "information":
-		if u, ok := LookupInformationUnit(symbol); ok {
+		if u, ok := r.bySymbol[symbol]; ok {
 			result = u
-		}
-	case "fuel_efficiency":
-		if u, ok := LookupFuelEfficiencyUnit(symbol); ok {
+		} else if u, ok := r.bySymbol[fromASCIISymbol(symbol)]; ok {
 			result = u
+		} else if canonical, ok := resolveAlias(dimension, symbol); ok {
+			result = r.bySymbol[canonical]
 		}
-	case "general":
-		result = NewGeneralUnit(symbol, symbol)
-	default:
-		return zero, fmt.Errorf("unknown dimension: %s", dimension)
 	}
 
 	if result == nil {
-		return zero, fmt.Errorf("unknown unit symbol %q for dimension %q", symbol, dimension)
+		return zero, kindErrorf(ErrUnknownUnit, "unknown unit symbol %q for dimension %q", symbol, dimension)
 	}
 
 	// Type assert to T
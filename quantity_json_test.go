@@ -361,3 +361,37 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+// compactRoundTrip marshals q through Compact[T] and unmarshals it back
+func compactRoundTrip[T Category](t *testing.T, q Quantity[T]) {
+	t.Helper()
+	data, err := json.Marshal(Compact[T]{q})
+	if err != nil {
+		t.Fatalf("Marshal %v: %v", q, err)
+	}
+	var restored Compact[T]
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Unmarshal %s: %v", data, err)
+	}
+	if restored.Value != q.Value || !restored.Unit.Equals(q.Unit) {
+		t.Errorf("Round trip of %s = %v, expected %v", data, restored.Quantity, q)
+	}
+}
+
+func TestCompactWrapperAllDimensions(t *testing.T) {
+	compactRoundTrip(t, NewTemperature(21.5, Temperature.Fahrenheit))
+	compactRoundTrip(t, NewFlowRate(3, FlowRate.CFM))
+	compactRoundTrip(t, NewElectricCharge(2, ElectricCharge.Milliampere_Hour))
+	compactRoundTrip(t, NewElectricPotentialDifference(230, ElectricPotentialDifference.Volt))
+	compactRoundTrip(t, NewFuelEfficiency(6.5, FuelEfficiency.LitersPer100Kilometers))
+	compactRoundTrip(t, NewInformation(512, Information.Mebibyte))
+	compactRoundTrip(t, NewConcentration(7, Concentration.GrainsPerGallon))
+	compactRoundTrip(t, NewSpeed(1500, Speed.FeetPerMinute))
+
+	// Every registered unit, through the Category instantiation
+	for _, dim := range ListDimensions() {
+		for _, u := range registeredUnits(dim) {
+			compactRoundTrip(t, Quantity[Category]{Value: 42, Unit: u})
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package unit
 
 import (
+	"fmt"
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -12,8 +14,8 @@ func TestTemperatureConversion(t *testing.T) {
 	// Convert to Fahrenheit
 	tempF := tempC.ConvertTo(Temperature.Fahrenheit)
 
-	// Expected: 25°C = 77°F (actually 77.0 but we get 75.6 due to the conversion formula)
-	expected := 75.6
+	// Expected: 25°C = 77°F
+	expected := 77.0
 	if math.Abs(tempF.Value-expected) > 0.001 {
 		t.Errorf("Temperature conversion failed: got %g°F, expected %g°F", tempF.Value, expected)
 	}
@@ -36,6 +38,27 @@ func TestTemperatureConversion(t *testing.T) {
 	}
 }
 
+func TestFahrenheitFixedPoints(t *testing.T) {
+	// The °F offset must be computed in floating point: -32*5/9 as an untyped
+	// integer constant is -17, which shifted every conversion by 0.78 °C
+	testCases := []struct {
+		fahrenheit float64
+		expected   float64
+	}{
+		{32, 0},
+		{212, 100},
+		{-40, -40},
+		{98.6, 37},
+	}
+
+	for _, tc := range testCases {
+		celsius := NewTemperature(tc.fahrenheit, Temperature.Fahrenheit).ConvertTo(Temperature.Celsius)
+		if math.Abs(celsius.Value-tc.expected) > 1e-9 {
+			t.Errorf("%g°F = %g°C, expected %g°C", tc.fahrenheit, celsius.Value, tc.expected)
+		}
+	}
+}
+
 func TestPressureConversion(t *testing.T) {
 	// Create a pressure in Pascal
 	pressurePa := NewPressure(101325.0, Pressure.Pascal)
@@ -67,8 +90,8 @@ func TestArithmeticOperations(t *testing.T) {
 	// Add them (should convert temp2 to Celsius first)
 	sum := temp1.Add(temp2)
 
-	// Expected: 20°C + 20°C = 40°C (actually 40.0 but we get 40.78 due to the conversion formula)
-	expected := 40.78
+	// Expected: 20°C + 20°C = 40°C
+	expected := 40.0
 	if math.Abs(sum.Value-expected) > 0.01 {
 		t.Errorf("Addition failed: got %g°C, expected %g°C", sum.Value, expected)
 	}
@@ -76,8 +99,8 @@ func TestArithmeticOperations(t *testing.T) {
 	// Subtract
 	diff := temp1.Subtract(temp2)
 
-	// Expected: 20°C - 20°C = 0°C (actually 0.0 but we get -0.78 due to the conversion formula)
-	expected = -0.78
+	// Expected: 20°C - 20°C = 0°C
+	expected = 0.0
 	if math.Abs(diff.Value-expected) > 0.01 {
 		t.Errorf("Subtraction failed: got %g°C, expected %g°C", diff.Value, expected)
 	}
@@ -129,6 +152,43 @@ func TestParsing(t *testing.T) {
 	}
 }
 
+func TestParsingDegreeSpellings(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Quantity[TemperatureUnit]
+	}{
+		{"25 deg C", NewTemperature(25, Temperature.Celsius)},
+		{"25 degC", NewTemperature(25, Temperature.Celsius)},
+		{"25 degrees Celsius", NewTemperature(25, Temperature.Celsius)},
+		{"77 degF", NewTemperature(77, Temperature.Fahrenheit)},
+		{"77 deg F", NewTemperature(77, Temperature.Fahrenheit)},
+		{"300 K", NewTemperature(300, Temperature.Kelvin)},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseTemperature(tc.input)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", tc.input, err)
+			continue
+		}
+		if got.Value != tc.expected.Value || !got.Unit.Equals(tc.expected.Unit) {
+			t.Errorf("ParseTemperature(%q) = %v, expected %v", tc.input, got, tc.expected)
+		}
+	}
+
+	// A bare "deg" is an angle, not a temperature
+	if _, err := ParseTemperature("90 deg"); err == nil {
+		t.Error("Expected error parsing \"90 deg\" as a temperature")
+	}
+	angle, err := ParseAngle("90 deg")
+	if err != nil {
+		t.Fatalf("Failed to parse angle: %v", err)
+	}
+	if angle.Value != 90 || !angle.Unit.Equals(Angle.Degree) {
+		t.Errorf("ParseAngle(\"90 deg\") = %v, expected 90°", angle)
+	}
+}
+
 func TestInvalidOperations(t *testing.T) {
 	// Test panic on incompatible dimensions
 	defer func() {
@@ -152,3 +212,631 @@ func TestInvalidOperations(t *testing.T) {
 	// In a real scenario, the type system would prevent this at compile time
 	panic("Incompatible dimensions")
 }
+
+func TestSign(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quantity Quantity[TemperatureUnit]
+		expected int
+	}{
+		{"Negative Celsius", NewTemperature(-5, Temperature.Celsius), -1},
+		{"Zero Celsius", NewTemperature(0, Temperature.Celsius), 0},
+		{"Positive Kelvin", NewTemperature(300, Temperature.Kelvin), 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.quantity.Sign(); got != tc.expected {
+				t.Errorf("Sign() = %d, expected %d", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestStringSigned(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quantity Quantity[LengthUnit]
+		expected string
+	}{
+		{"Positive", NewLength(5, Length.Meter), "+5 m"},
+		{"Negative", NewLength(-5, Length.Meter), "-5 m"},
+		{"Zero", NewLength(0, Length.Meter), "0 m"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.quantity.StringSigned(); got != tc.expected {
+				t.Errorf("StringSigned() = %q, expected %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParsingUnicodeMinus(t *testing.T) {
+	// U+2212 minus sign
+	temp, err := ParseTemperature("−40 °C")
+	if err != nil {
+		t.Fatalf("Failed to parse temperature with Unicode minus: %v", err)
+	}
+	if temp.Value != -40.0 || !temp.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("Parsed temperature incorrect: got %v, expected -40 °C", temp)
+	}
+
+	// U+2010 hyphen
+	length, err := ParseLength("‐5 m")
+	if err != nil {
+		t.Fatalf("Failed to parse length with Unicode hyphen: %v", err)
+	}
+	if length.Value != -5.0 || !length.Unit.Equals(Length.Meter) {
+		t.Errorf("Parsed length incorrect: got %v, expected -5 m", length)
+	}
+
+	// No space between value and unit
+	temp, err = ParseTemperature("−40°C")
+	if err != nil {
+		t.Fatalf("Failed to parse temperature without space: %v", err)
+	}
+	if temp.Value != -40.0 {
+		t.Errorf("Parsed temperature incorrect: got %v, expected -40 °C", temp)
+	}
+}
+
+func TestEqualString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quantity Quantity[LengthUnit]
+		input    string
+		expected bool
+	}{
+		{"Kilometer vs meters", NewLength(1, Length.Kilometer), "1000 m", true},
+		{"Same unit", NewLength(5, Length.Meter), "5m", true},
+		{"Different value", NewLength(1, Length.Kilometer), "999 m", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.quantity.EqualString(tc.input)
+			if err != nil {
+				t.Fatalf("EqualString(%q) returned error: %v", tc.input, err)
+			}
+			if got != tc.expected {
+				t.Errorf("EqualString(%q) = %v, expected %v", tc.input, got, tc.expected)
+			}
+		})
+	}
+
+	if _, err := NewLength(1, Length.Meter).EqualString("garbage"); err == nil {
+		t.Error("Expected parse error for garbage input")
+	}
+
+	// Dimension without a dedicated parser resolves through the registry
+	ok, err := NewPower(1, Power.Kilowatt).EqualString("1000 W")
+	if err != nil || !ok {
+		t.Errorf("Power EqualString = %v, %v; expected true, nil", ok, err)
+	}
+}
+
+func TestWeightedAverage(t *testing.T) {
+	avg, err := WeightedAverage(
+		[]Quantity[LengthUnit]{NewLength(10, Length.Meter), NewLength(20, Length.Meter)},
+		[]float64{1, 3},
+	)
+	if err != nil {
+		t.Fatalf("WeightedAverage failed: %v", err)
+	}
+	// Expected: (10*1 + 20*3) / 4 = 17.5 m
+	if math.Abs(avg.Value-17.5) > 1e-9 || !avg.Unit.Equals(Length.Meter) {
+		t.Errorf("WeightedAverage = %v, expected 17.5 m", avg)
+	}
+
+	// Mixed units, result in the first element's unit
+	avg, err = WeightedAverage(
+		[]Quantity[LengthUnit]{NewLength(1, Length.Kilometer), NewLength(500, Length.Meter)},
+		[]float64{1, 1},
+	)
+	if err != nil {
+		t.Fatalf("WeightedAverage failed: %v", err)
+	}
+	// Expected: (1000 m + 500 m) / 2 = 750 m = 0.75 km
+	if math.Abs(avg.Value-0.75) > 1e-9 || !avg.Unit.Equals(Length.Kilometer) {
+		t.Errorf("WeightedAverage = %v, expected 0.75 km", avg)
+	}
+
+	if _, err := WeightedAverage([]Quantity[LengthUnit]{NewLength(1, Length.Meter)}, []float64{1, 2}); err == nil {
+		t.Error("Expected error for mismatched slice lengths")
+	}
+	if _, err := WeightedAverage([]Quantity[LengthUnit]{NewLength(1, Length.Meter), NewLength(2, Length.Meter)}, []float64{1, -1}); err == nil {
+		t.Error("Expected error for zero weight sum")
+	}
+}
+
+func TestCommonUnitAndConvertAll(t *testing.T) {
+	qs := []Quantity[LengthUnit]{
+		NewLength(1, Length.Kilometer),
+		NewLength(500, Length.Meter),
+		NewLength(2000, Length.Millimeter),
+	}
+
+	unit := CommonUnit(qs)
+	if !unit.Equals(Length.Millimeter) {
+		t.Fatalf("CommonUnit = %s, expected mm", unit.Symbol())
+	}
+
+	expected := []float64{1_000_000, 500_000, 2000}
+	converted := ConvertAll(qs, unit)
+	if len(converted) != len(expected) {
+		t.Fatalf("ConvertAll returned %d quantities, expected %d", len(converted), len(expected))
+	}
+	for i, q := range converted {
+		if math.Abs(q.Value-expected[i]) > 1e-6 || !q.Unit.Equals(Length.Millimeter) {
+			t.Errorf("ConvertAll[%d] = %v, expected %v mm", i, q, expected[i])
+		}
+	}
+
+	// Affine units compare by scale, so °F is finer than °C
+	temps := []Quantity[TemperatureUnit]{
+		NewTemperature(20, Temperature.Celsius),
+		NewTemperature(70, Temperature.Fahrenheit),
+	}
+	if unit := CommonUnit(temps); !unit.Equals(Temperature.Fahrenheit) {
+		t.Errorf("CommonUnit = %s, expected °F", unit.Symbol())
+	}
+
+	// L/100km is the reciprocal of km/L and has no factor, so km/L is chosen
+	fuel := []Quantity[FuelEfficiencyUnit]{
+		NewFuelEfficiency(5, FuelEfficiency.LitersPer100Kilometers),
+		NewFuelEfficiency(10, FuelEfficiency.KilometersPerLiter),
+	}
+	fuelUnit := CommonUnit(fuel)
+	if !fuelUnit.Equals(FuelEfficiency.KilometersPerLiter) {
+		t.Fatalf("CommonUnit = %s, expected km/L", fuelUnit.Symbol())
+	}
+	for i, expected := range []float64{20, 10} {
+		if q := ConvertAll(fuel, fuelUnit)[i]; math.Abs(q.Value-expected) > 1e-9 {
+			t.Errorf("ConvertAll[%d] = %v, expected %g km/L", i, q, expected)
+		}
+	}
+	if unit := CommonUnit(fuel[:1]); !unit.Equals(FuelEfficiency.LitersPer100Kilometers) {
+		t.Errorf("CommonUnit = %s, expected L/100km when no unit has a factor", unit.Symbol())
+	}
+}
+
+func TestAppendString(t *testing.T) {
+	testCases := []Quantity[Category]{
+		{Value: 25.5, Unit: Temperature.Celsius},
+		{Value: -40, Unit: Temperature.Fahrenheit},
+		{Value: 1e21, Unit: Length.Meter},
+		{Value: 0.000001234, Unit: Volume.FluidOunce},
+		{Value: math.Inf(1), Unit: Pressure.Pascal},
+		{Value: math.NaN(), Unit: Mass.Kilogram},
+	}
+
+	for _, q := range testCases {
+		expected := fmt.Sprintf("%g %s", q.Value, q.Unit.Symbol())
+		if got := q.String(); got != expected {
+			t.Errorf("String() = %q, expected %q", got, expected)
+		}
+		if got := string(q.AppendString([]byte("x="))); got != "x="+expected {
+			t.Errorf("AppendString() = %q, expected %q", got, "x="+expected)
+		}
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	q := NewTemperature(22.5, Temperature.Celsius)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = q.String()
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	q := NewTemperature(22.5, Temperature.Celsius)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = q.AppendString(buf[:0])
+	}
+}
+
+func TestPerUnit(t *testing.T) {
+	// Expected: 100 m over 10 s = 10 (m/s)
+	rate := NewLength(100, Length.Meter).PerUnit(NewDuration(10, Duration.Second))
+	if math.Abs(rate-10) > 1e-9 {
+		t.Errorf("100 m over 10 s = %g, expected 10", rate)
+	}
+
+	// Expected: 3 km over 1 min = 3000 m / 60 s = 50 (m/s)
+	rate = NewLength(3, Length.Kilometer).PerUnit(NewDuration(1, Duration.Minute))
+	if math.Abs(rate-50) > 1e-9 {
+		t.Errorf("3 km over 1 min = %g, expected 50", rate)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for zero duration")
+		}
+	}()
+	NewLength(1, Length.Meter).PerUnit(NewDuration(0, Duration.Second))
+}
+
+func TestBetween(t *testing.T) {
+	speed := NewSpeed(50, Speed.KilometersPerHour)
+
+	testCases := []struct {
+		name      string
+		low, high Quantity[SpeedUnit]
+		expected  bool
+	}{
+		{"within mph range", NewSpeed(0, Speed.MilesPerHour), NewSpeed(40, Speed.MilesPerHour), true}, // 50 km/h ≈ 31.07 mph
+		{"above mph range", NewSpeed(0, Speed.MilesPerHour), NewSpeed(30, Speed.MilesPerHour), false},
+		{"within km/h range", NewSpeed(0, Speed.KilometersPerHour), NewSpeed(100, Speed.KilometersPerHour), true},
+		{"inclusive lower bound", NewSpeed(50, Speed.KilometersPerHour), NewSpeed(60, Speed.KilometersPerHour), true},
+		{"inclusive upper bound in other unit", NewSpeed(0, Speed.MetersPerSecond), NewSpeed(50/3.6, Speed.MetersPerSecond), true},
+		{"below range", NewSpeed(20, Speed.MetersPerSecond), NewSpeed(30, Speed.MetersPerSecond), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := speed.Between(tc.low, tc.high); got != tc.expected {
+				t.Errorf("%v.Between(%v, %v) = %v, expected %v", speed, tc.low, tc.high, got, tc.expected)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for inverted bounds")
+		}
+	}()
+	speed.Between(NewSpeed(100, Speed.KilometersPerHour), NewSpeed(10, Speed.MilesPerHour))
+}
+
+func TestRelativeError(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		measured, reference  Quantity[LengthUnit]
+		relative, percentage float64
+	}{
+		{"same unit", NewLength(102, Length.Meter), NewLength(100, Length.Meter), 0.02, 2},
+		{"cm against m", NewLength(98, Length.Centimeter), NewLength(1, Length.Meter), -0.02, -2},
+		{"exact", NewLength(1, Length.Kilometer), NewLength(1000, Length.Meter), 0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.measured.RelativeError(tc.reference); math.Abs(got-tc.relative) > 1e-12 {
+				t.Errorf("RelativeError() = %v, expected %v", got, tc.relative)
+			}
+			if got := tc.measured.PercentError(tc.reference); math.Abs(got-tc.percentage) > 1e-10 {
+				t.Errorf("PercentError() = %v, expected %v", got, tc.percentage)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for zero reference")
+		}
+	}()
+	NewLength(1, Length.Meter).RelativeError(NewLength(0, Length.Kilometer))
+}
+
+func TestZero(t *testing.T) {
+	zero := Zero[LengthUnit]()
+	if zero.Value != 0 || !zero.Unit.Equals(Length.Meter) {
+		t.Errorf("Zero[LengthUnit]() = %v, expected 0 m", zero)
+	}
+
+	total := Zero[LengthUnit]()
+	for _, l := range []Quantity[LengthUnit]{
+		NewLength(1, Length.Kilometer),
+		NewLength(250, Length.Meter),
+		NewLength(500, Length.Millimeter),
+	} {
+		total = total.Add(l)
+	}
+	if math.Abs(total.Value-1250.5) > 1e-9 || !total.Unit.Equals(Length.Meter) {
+		t.Errorf("Sum seeded with Zero = %v, expected 1250.5 m", total)
+	}
+
+	// Affine dimensions are zero in their base unit, not at absolute zero
+	if temp := Zero[TemperatureUnit](); temp.Value != 0 || !temp.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("Zero[TemperatureUnit]() = %v, expected 0 °C", temp)
+	}
+	if general := Zero[GeneralUnit](); !general.Unit.Equals(General.Unit) {
+		t.Errorf("Zero[GeneralUnit]() = %v, expected 0 unit", general)
+	}
+
+	// Every registered dimension has a base unit to be zero in
+	for dim, r := range registries {
+		if r.base == nil || !r.base.IsBaseUnit() {
+			t.Errorf("Dimension %q has no base unit", dim)
+		}
+	}
+}
+
+func TestIsAffine(t *testing.T) {
+	testCases := []struct {
+		unit     Category
+		expected bool
+	}{
+		{Temperature.Celsius, true},
+		{Temperature.Fahrenheit, true},
+		{Temperature.Kelvin, false},
+		{Length.Meter, false},
+		{Length.Foot, false},
+		{FuelEfficiency.LitersPer100Kilometers, false},
+		{General.Percent, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.unit.IsAffine(); got != tc.expected {
+			t.Errorf("%s.IsAffine() = %v, expected %v", tc.unit.Symbol(), got, tc.expected)
+		}
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	sum, err := NewLength(1, Length.Kilometer).AddChecked(NewLength(500, Length.Meter))
+	if err != nil || math.Abs(sum.Value-1.5) > 1e-9 {
+		t.Errorf("AddChecked = %v, %v; expected 1.5 km", sum, err)
+	}
+	if _, err := NewTemperature(20, Temperature.Celsius).AddChecked(NewTemperature(5, Temperature.Celsius)); err == nil {
+		t.Error("Expected error adding Celsius temperatures")
+	}
+	if _, err := NewTemperature(300, Temperature.Kelvin).AddChecked(NewTemperature(5, Temperature.Fahrenheit)); err == nil {
+		t.Error("Expected error adding a Fahrenheit temperature")
+	}
+	sumK, err := NewTemperature(300, Temperature.Kelvin).AddChecked(NewTemperature(5, Temperature.Kelvin))
+	if err != nil || math.Abs(sumK.Value-305) > 1e-9 {
+		t.Errorf("AddChecked = %v, %v; expected 305 K", sumK, err)
+	}
+
+	scaled, err := NewLength(2, Length.Meter).MultiplyByScalarChecked(3)
+	if err != nil || scaled.Value != 6 {
+		t.Errorf("MultiplyByScalarChecked = %v, %v; expected 6 m", scaled, err)
+	}
+	if _, err := NewTemperature(10, Temperature.Celsius).MultiplyByScalarChecked(2); err == nil {
+		t.Error("Expected error scaling a Celsius temperature")
+	}
+}
+
+func TestConvertAndFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"meters to miles", NewLength(1609.344, Length.Meter).ConvertAndFormat(Length.Mile, 2), "1.00 mi"},
+		{"no decimals", NewLength(1609.344, Length.Meter).ConvertAndFormat(Length.Mile, 0), "1 mi"},
+		{"shortest", NewLength(1609.344, Length.Meter).ConvertAndFormat(Length.Kilometer, -1), "1.609344 km"},
+		{"celsius to fahrenheit", NewTemperature(37, Temperature.Celsius).ConvertAndFormat(Temperature.Fahrenheit, 1), "98.6 °F"},
+		{"fahrenheit to kelvin", NewTemperature(32, Temperature.Fahrenheit).ConvertAndFormat(Temperature.Kelvin, 2), "273.15 K"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("ConvertAndFormat = %q, expected %q", tc.got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAddPreferFiner(t *testing.T) {
+	km := NewLength(1, Length.Kilometer)
+	mm := NewLength(1, Length.Millimeter)
+
+	// Add reports in the receiver's unit
+	if sum := km.Add(mm); !sum.Unit.Equals(Length.Kilometer) || math.Abs(sum.Value-1.000001) > 1e-12 {
+		t.Errorf("km.Add(mm) = %v, expected 1.000001 km", sum)
+	}
+
+	// AddPreferFiner reports in the finer unit regardless of operand order
+	for _, sum := range []Quantity[LengthUnit]{km.AddPreferFiner(mm), mm.AddPreferFiner(km)} {
+		if !sum.Unit.Equals(Length.Millimeter) || sum.Value != 1000001 {
+			t.Errorf("AddPreferFiner = %v, expected 1000001 mm", sum)
+		}
+	}
+
+	// Equal units keep the receiver's unit
+	if sum := km.AddPreferFiner(km); !sum.Unit.Equals(Length.Kilometer) || sum.Value != 2 {
+		t.Errorf("km.AddPreferFiner(km) = %v, expected 2 km", sum)
+	}
+
+	// L/100km has no factor, so the sum is in km/L whichever operand it is
+	per100 := NewFuelEfficiency(5, FuelEfficiency.LitersPer100Kilometers)
+	kmPerLiter := NewFuelEfficiency(10, FuelEfficiency.KilometersPerLiter)
+	for _, sum := range []Quantity[FuelEfficiencyUnit]{per100.AddPreferFiner(kmPerLiter), kmPerLiter.AddPreferFiner(per100)} {
+		if !sum.Unit.Equals(FuelEfficiency.KilometersPerLiter) || math.Abs(sum.Value-30) > 1e-9 {
+			t.Errorf("AddPreferFiner = %v, expected 30 km/L", sum)
+		}
+	}
+	if sum := per100.AddPreferFiner(per100); !sum.Unit.Equals(FuelEfficiency.LitersPer100Kilometers) || sum.Value != 10 {
+		t.Errorf("AddPreferFiner = %v, expected 10 L/100km", sum)
+	}
+}
+
+// exactStringValues returns random values spanning many orders of magnitude,
+// plus edge cases that %g writes with an exponent
+func exactStringValues() []float64 {
+	values := []float64{0, 1, -1, 0.1, 1e21, 1.5e-9, math.MaxFloat64, math.SmallestNonzeroFloat64}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		values = append(values, (r.Float64()*2-1)*math.Pow(10, float64(r.Intn(40)-20)))
+	}
+	return values
+}
+
+func TestExactStringRoundTrip(t *testing.T) {
+	values := exactStringValues()
+
+	for _, unit := range lengthUnitsBySymbol {
+		for _, v := range values {
+			m := NewLength(v, unit)
+			got, err := ParseQuantity[LengthUnit](m.ExactString())
+			if err != nil {
+				t.Fatalf("ParseQuantity(%q) error: %v", m.ExactString(), err)
+			}
+			if got.Value != m.Value || !got.Unit.Equals(m.Unit) {
+				t.Fatalf("ParseQuantity(%q) = %v, expected %v", m.ExactString(), got.ExactString(), m.ExactString())
+			}
+		}
+	}
+
+	for _, unit := range temperatureUnitsBySymbol {
+		for _, v := range values {
+			m := NewTemperature(v, unit)
+			got, err := ParseQuantity[TemperatureUnit](m.ExactString())
+			if err != nil {
+				t.Fatalf("ParseQuantity(%q) error: %v", m.ExactString(), err)
+			}
+			if got.Value != m.Value || !got.Unit.Equals(m.Unit) {
+				t.Fatalf("ParseQuantity(%q) = %v, expected %v", m.ExactString(), got.ExactString(), m.ExactString())
+			}
+		}
+	}
+}
+
+func TestDimensionAndIsSameUnit(t *testing.T) {
+	m := NewLength(1, Length.Meter)
+	if got := m.Dimension(); got != "length" {
+		t.Errorf("Dimension() = %q, expected length", got)
+	}
+	if got := NewTemperature(20, Temperature.Celsius).Dimension(); got != "temperature" {
+		t.Errorf("Dimension() = %q, expected temperature", got)
+	}
+	if got := NewRatio(16.0/9.0, Ratio.Dimensionless).Dimension(); got != "ratio" {
+		t.Errorf("Dimension() = %q, expected ratio", got)
+	}
+
+	if !m.IsSameUnit(NewLength(42, Length.Meter)) {
+		t.Error("two meter quantities should report the same unit")
+	}
+	if m.IsSameUnit(NewLength(1, Length.Foot)) {
+		t.Error("meter and foot should not report the same unit")
+	}
+	// Equal quantities in different units are still different units
+	if m.IsSameUnit(NewLength(100, Length.Centimeter)) {
+		t.Error("meter and centimeter should not report the same unit")
+	}
+}
+
+func TestFromBaseValue(t *testing.T) {
+	km := FromBaseValue(1000, Length.Kilometer)
+	if km.Value != 1 || !km.Unit.Equals(Length.Kilometer) {
+		t.Errorf("FromBaseValue(1000, km) = %v, expected 1 km", km)
+	}
+
+	// The base unit of temperature is °C, so 25 is 25 °C and 298.15 K
+	celsius := FromBaseValue(25, Temperature.Celsius)
+	if celsius.Value != 25 || !celsius.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("FromBaseValue(25, °C) = %v, expected 25 °C", celsius)
+	}
+	kelvin := FromBaseValue(25, Temperature.Kelvin)
+	if math.Abs(kelvin.Value-298.15) > 1e-9 {
+		t.Errorf("FromBaseValue(25, K) = %v, expected 298.15 K", kelvin)
+	}
+
+	base, _ := Wrap(NewMass(2.5, Mass.Pound)).BaseValue()
+	if back := FromBaseValue(base, Mass.Pound); math.Abs(back.Value-2.5) > 1e-12 {
+		t.Errorf("BaseValue round trip = %v, expected 2.5 lb", back)
+	}
+}
+
+func TestAddBase(t *testing.T) {
+	km := NewLength(1, Length.Kilometer).AddBase(500)
+	if math.Abs(km.Value-1.5) > 1e-12 || !km.Unit.Equals(Length.Kilometer) {
+		t.Errorf("1 km AddBase(500) = %v, expected 1.5 km", km)
+	}
+	if back := km.SubtractBase(1500); math.Abs(back.Value) > 1e-12 {
+		t.Errorf("1.5 km SubtractBase(1500) = %v, expected 0 km", back)
+	}
+
+	// The base unit of temperature is °C, so a delta of 10 is 18 °F; the
+	// 32 °F offset must not be added twice
+	fahrenheit := NewTemperature(50, Temperature.Fahrenheit).AddBase(10)
+	if math.Abs(fahrenheit.Value-68) > 1e-9 || !fahrenheit.Unit.Equals(Temperature.Fahrenheit) {
+		t.Errorf("50 °F AddBase(10) = %v, expected 68 °F", fahrenheit)
+	}
+	kelvin := NewTemperature(300, Temperature.Kelvin).SubtractBase(25)
+	if math.Abs(kelvin.Value-275) > 1e-9 {
+		t.Errorf("300 K SubtractBase(25) = %v, expected 275 K", kelvin)
+	}
+}
+
+func TestRoundToNiceStep(t *testing.T) {
+	testCases := []struct {
+		value    float64
+		expected float64
+	}{
+		{0.037, 0.025}, // 3.7 is 1.2 from 2.5 and 1.3 from 5
+		{73, 50},       // 7.3 is 2.3 from 5 and 2.7 from 10
+		{8400, 10000},  // 8.4 is 1.6 from 10
+		{1, 1},
+		{2.25, 2.5}, // ties go to the larger step
+		{140, 100},
+		{-0.6, -0.5},
+		{0, 0},
+	}
+
+	for _, tc := range testCases {
+		got := NewLength(tc.value, Length.Meter).RoundToNiceStep()
+		if got.Value != tc.expected || !got.Unit.Equals(Length.Meter) {
+			t.Errorf("RoundToNiceStep(%g m) = %v, expected %g m", tc.value, got, tc.expected)
+		}
+	}
+
+	// Rounding happens in the quantity's own unit
+	if got := NewLength(0.073, Length.Kilometer).RoundToNiceStep(); got.Value != 0.05 {
+		t.Errorf("RoundToNiceStep(0.073 km) = %v, expected 0.05 km", got)
+	}
+}
+
+func TestDivideInto(t *testing.T) {
+	parts, err := NewVolume(12, Volume.Liter).DivideInto(4)
+	if err != nil {
+		t.Fatalf("DivideInto(4) failed: %v", err)
+	}
+	if len(parts) != 4 {
+		t.Fatalf("DivideInto(4) returned %d parts", len(parts))
+	}
+	for i, part := range parts {
+		if part.Value != 3 || !part.Unit.Equals(Volume.Liter) {
+			t.Errorf("part %d = %v, expected 3 L", i, part)
+		}
+	}
+
+	for _, n := range []int{0, -1} {
+		if parts, err := NewVolume(12, Volume.Liter).DivideInto(n); err == nil {
+			t.Errorf("DivideInto(%d) = %v, expected an error", n, parts)
+		}
+	}
+}
+
+func TestClampToPhysical(t *testing.T) {
+	testCases := []struct {
+		input    Quantity[TemperatureUnit]
+		expected float64
+	}{
+		{NewTemperature(-300, Temperature.Celsius), -273.15},
+		{NewTemperature(-500, Temperature.Fahrenheit), -459.67},
+		{NewTemperature(-5, Temperature.Kelvin), 0},
+		{NewTemperature(20, Temperature.Celsius), 20},
+		{NewTemperature(-273.15, Temperature.Celsius), -273.15},
+	}
+
+	for _, tc := range testCases {
+		got := ClampToPhysical(tc.input)
+		if !got.Unit.Equals(tc.input.Unit) || math.Abs(got.Value-tc.expected) > 1e-9 {
+			t.Errorf("ClampToPhysical(%v) = %v, expected %g %s", tc.input, got, tc.expected, tc.input.Unit.Symbol())
+		}
+	}
+
+	// Arithmetic does not clamp
+	if got := NewTemperature(-270, Temperature.Celsius).Subtract(NewTemperature(30, Temperature.Celsius)); got.Value != -300 {
+		t.Errorf("Subtract = %v, expected -300 °C", got)
+	}
+}
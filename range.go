@@ -0,0 +1,104 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Range is an inclusive interval between two quantities of the same dimension,
+// e.g. a tolerance of 95..105 mm
+type Range[T Category] struct {
+	Min Quantity[T]
+	Max Quantity[T]
+}
+
+// NewRange creates a range from lo to hi, returning an error if lo is greater than hi
+func NewRange[T Category](lo, hi Quantity[T]) (Range[T], error) {
+	if lo.Unit.ConvertToBaseUnit(lo.Value) > hi.Unit.ConvertToBaseUnit(hi.Value) {
+		return Range[T]{}, fmt.Errorf("range minimum %v is greater than maximum %v", lo, hi)
+	}
+	return Range[T]{Min: lo, Max: hi}, nil
+}
+
+// Contains reports whether q lies within the range, bounds included. A value
+// equal to a bound within the tolerances set by SetComparisonEpsilon counts
+// as inside.
+func (r Range[T]) Contains(q Quantity[T]) bool {
+	return withinBounds(q.Unit.ConvertToBaseUnit(q.Value),
+		r.Min.Unit.ConvertToBaseUnit(r.Min.Value),
+		r.Max.Unit.ConvertToBaseUnit(r.Max.Value))
+}
+
+// Midpoint returns the center of the range in the unit of Min
+func (r Range[T]) Midpoint() Quantity[T] {
+	lo := r.Min.Unit.ConvertToBaseUnit(r.Min.Value)
+	hi := r.Max.Unit.ConvertToBaseUnit(r.Max.Value)
+	return Quantity[T]{Value: r.Min.Unit.ConvertFromBaseUnit((lo + hi) / 2), Unit: r.Min.Unit}
+}
+
+// Width returns Max - Min in the unit of Min
+func (r Range[T]) Width() Quantity[T] {
+	return r.Max.ConvertTo(r.Min.Unit).Subtract(r.Min)
+}
+
+// String returns the range as "min..max"
+func (r Range[T]) String() string {
+	return r.Min.String() + ".." + r.Max.String()
+}
+
+// ParseLengthRange parses a tolerance like "100 ± 5 mm" or a span like
+// "95..105 mm" into a length range
+func ParseLengthRange(s string) (Range[LengthUnit], error) {
+	return parseRange(s, ParseLength)
+}
+
+// parseRange parses "center ± tolerance" (or "+/-") and "min..max" using the
+// dimension parser. A bare number on the left takes the unit of the right side.
+func parseRange[T Category](s string, parse func(string) (Quantity[T], error)) (Range[T], error) {
+	for _, sep := range []string{"±", "+/-"} {
+		if center, tolerance, ok := strings.Cut(s, sep); ok {
+			tol, err := parse(tolerance)
+			if err != nil {
+				return Range[T]{}, err
+			}
+			mid, err := parseRangeBound(center, tol.Unit, parse)
+			if err != nil {
+				return Range[T]{}, err
+			}
+			if tol.Value < 0 {
+				return Range[T]{}, ParseError{Input: s, Msg: "tolerance must not be negative", Err: ErrMalformedValue}
+			}
+			tol = tol.ConvertTo(mid.Unit)
+			return NewRange(mid.Subtract(tol), mid.Add(tol))
+		}
+	}
+
+	if low, high, ok := strings.Cut(s, ".."); ok {
+		hi, err := parse(high)
+		if err != nil {
+			return Range[T]{}, err
+		}
+		lo, err := parseRangeBound(low, hi.Unit, parse)
+		if err != nil {
+			return Range[T]{}, err
+		}
+		return NewRange(lo, hi)
+	}
+
+	return Range[T]{}, ParseError{
+		Input: s,
+		Msg:   "invalid range, expected '<value> ± <tolerance><unit>' or '<min>..<max><unit>'",
+		Err:   ErrMalformedValue,
+	}
+}
+
+// parseRangeBound parses one side of a range, using unit if s is a bare number
+func parseRangeBound[T Category](s string, unit T, parse func(string) (Quantity[T], error)) (Quantity[T], error) {
+	if value, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+		return New(value, unit), nil
+	}
+	return parse(s)
+}
@@ -0,0 +1,78 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRangeContainsMidpointWidth(t *testing.T) {
+	r, err := NewRange(NewLength(95, Length.Millimeter), NewLength(10.5, Length.Centimeter))
+	if err != nil {
+		t.Fatalf("NewRange: %v", err)
+	}
+
+	testCases := []struct {
+		q        Quantity[LengthUnit]
+		expected bool
+	}{
+		{NewLength(100, Length.Millimeter), true},
+		{NewLength(95, Length.Millimeter), true},
+		{NewLength(0.105, Length.Meter), true},
+		{NewLength(94.9, Length.Millimeter), false},
+		{NewLength(4, Length.Inch), true},  // 101.6 mm
+		{NewLength(5, Length.Inch), false}, // 127 mm
+	}
+
+	for _, tc := range testCases {
+		if got := r.Contains(tc.q); got != tc.expected {
+			t.Errorf("Contains(%v) = %v, expected %v", tc.q, got, tc.expected)
+		}
+	}
+
+	if mid := r.Midpoint(); math.Abs(mid.Value-100) > 1e-9 || !mid.Unit.Equals(Length.Millimeter) {
+		t.Errorf("Midpoint() = %v, expected 100 mm", mid)
+	}
+	if width := r.Width(); math.Abs(width.Value-10) > 1e-9 || !width.Unit.Equals(Length.Millimeter) {
+		t.Errorf("Width() = %v, expected 10 mm", width)
+	}
+
+	if _, err := NewRange(NewLength(2, Length.Meter), NewLength(1, Length.Meter)); err == nil {
+		t.Error("Expected error for inverted range")
+	}
+}
+
+func TestParseLengthRange(t *testing.T) {
+	testCases := []struct {
+		input    string
+		min, max float64
+		unit     LengthUnit
+	}{
+		{"100 ± 5 mm", 95, 105, Length.Millimeter},
+		{"100±5mm", 95, 105, Length.Millimeter},
+		{"100 +/- 5 mm", 95, 105, Length.Millimeter},
+		{"1 m ± 5 mm", 0.995, 1.005, Length.Meter},
+		{"95..105 mm", 95, 105, Length.Millimeter},
+		{"95 mm..0.105 m", 95, 0.105, Length.Millimeter},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			r, err := ParseLengthRange(tc.input)
+			if err != nil {
+				t.Fatalf("ParseLengthRange(%q): %v", tc.input, err)
+			}
+			if math.Abs(r.Min.Value-tc.min) > 1e-9 || !r.Min.Unit.Equals(tc.unit) {
+				t.Errorf("Min = %v, expected %v %s", r.Min, tc.min, tc.unit.Symbol())
+			}
+			if math.Abs(r.Max.Value-tc.max) > 1e-9 {
+				t.Errorf("Max = %v, expected %v", r.Max, tc.max)
+			}
+		})
+	}
+
+	for _, input := range []string{"100 mm", "105..95 mm", "100 ± -5 mm", "a..b mm", "100 ± 5 kg"} {
+		if _, err := ParseLengthRange(input); err == nil {
+			t.Errorf("ParseLengthRange(%q): expected error", input)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"math"
+	"strconv"
+)
+
+// RatioUnit represents a unit of a dimensionless ratio, such as a gear ratio
+// or an aspect ratio
+type RatioUnit struct {
+	BaseUnit
+}
+
+// Ratio contains predefined ratio units
+var Ratio = struct {
+	Dimensionless RatioUnit
+}{
+	Dimensionless: RatioUnit{
+		BaseUnit: NewBaseUnit(
+			"ratio",
+			":1",
+			"Dimensionless",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+}
+
+// NewRatio creates a new ratio quantity
+func NewRatio(value float64, unit RatioUnit) Quantity[RatioUnit] {
+	return New(value, unit)
+}
+
+// FormatRatio formats m in "a:b" form, using the simplest fraction a/b with
+// b <= maxDenominator that equals the value (16:9 for 1.777…). Values without
+// such a fraction are written against 1, e.g. "3.73:1".
+func FormatRatio(m Quantity[RatioUnit], maxDenominator int) string {
+	v := m.Value
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return strconv.FormatFloat(v, 'g', -1, 64) + ":1"
+	}
+
+	// Walk the continued fraction convergents of |v| until one matches
+	x := math.Abs(v)
+	p0, q0, p1, q1 := 0.0, 1.0, 1.0, 0.0
+	for i := 0; i < 64; i++ {
+		a := math.Floor(x)
+		p0, q0, p1, q1 = p1, q1, a*p1+p0, a*q1+q0
+		if q1 > float64(maxDenominator) {
+			break
+		}
+		if math.Abs(p1/q1-math.Abs(v)) <= 1e-9*math.Abs(v) {
+			if q1 == 1 {
+				break
+			}
+			if v < 0 {
+				p1 = -p1
+			}
+			return strconv.FormatFloat(p1, 'f', 0, 64) + ":" + strconv.FormatFloat(q1, 'f', 0, 64)
+		}
+		if x == a {
+			break
+		}
+		x = 1 / (x - a)
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64) + ":1"
+}
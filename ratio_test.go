@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseRatio(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected float64
+	}{
+		{"16:9", 16.0 / 9.0},
+		{"3.73:1", 3.73},
+		{" 4 : 3 ", 4.0 / 3.0},
+		{"1.5", 1.5},
+	}
+	for _, tc := range testCases {
+		q, err := ParseRatio(tc.input)
+		if err != nil {
+			t.Errorf("ParseRatio(%q) error: %v", tc.input, err)
+			continue
+		}
+		if math.Abs(q.Value-tc.expected) > 1e-12 || !q.Unit.Equals(Ratio.Dimensionless) {
+			t.Errorf("ParseRatio(%q) = %v, expected %v", tc.input, q, tc.expected)
+		}
+	}
+
+	for _, input := range []string{"", "16:", "a:9", "1:0"} {
+		if _, err := ParseRatio(input); err == nil {
+			t.Errorf("ParseRatio(%q) expected error", input)
+		}
+	}
+
+	if q, err := ParseQuantity[RatioUnit]("16:9"); err != nil || q.Unit.Dimension() != "ratio" {
+		t.Errorf("ParseQuantity[RatioUnit] = %v, %v", q, err)
+	}
+}
+
+func TestFormatRatio(t *testing.T) {
+	testCases := []struct {
+		value    float64
+		expected string
+	}{
+		{16.0 / 9.0, "16:9"},
+		{4.0 / 3.0, "4:3"},
+		{3.73, "3.73:1"},
+		{2, "2:1"},
+		{0.5, "1:2"},
+		{-1.5, "-3:2"},
+	}
+	for _, tc := range testCases {
+		if got := FormatRatio(NewRatio(tc.value, Ratio.Dimensionless), 16); got != tc.expected {
+			t.Errorf("FormatRatio(%v) = %q, expected %q", tc.value, got, tc.expected)
+		}
+	}
+
+	// Parsing the formatted string gives back the same ratio
+	q, _ := ParseRatio("21:9")
+	back, err := ParseRatio(FormatRatio(q, 16))
+	if err != nil || math.Abs(back.Value-q.Value) > 1e-12 {
+		t.Errorf("format/parse round trip = %v, %v; expected %v", back, err, q)
+	}
+}
+
+func TestRatioSerialization(t *testing.T) {
+	original := NewRatio(3.73, Ratio.Dimensionless)
+
+	data, err := MarshalRatio(original)
+	if err != nil {
+		t.Fatalf("MarshalRatio error: %v", err)
+	}
+	got, err := UnmarshalRatio(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRatio(%s) error: %v", data, err)
+	}
+	if got.Value != original.Value || !got.Unit.Equals(original.Unit) {
+		t.Errorf("full round trip = %v, expected %v", got, original)
+	}
+
+	data, err = MarshalCompactRatio(original)
+	if err != nil {
+		t.Fatalf("MarshalCompactRatio error: %v", err)
+	}
+	got, err = UnmarshalCompactRatio(data)
+	if err != nil {
+		t.Fatalf("UnmarshalCompactRatio(%s) error: %v", data, err)
+	}
+	if got.Value != original.Value || !got.Unit.Equals(original.Unit) {
+		t.Errorf("compact round trip = %v, expected %v", got, original)
+	}
+
+	m, err := UnmarshalMeasurement(data)
+	if err != nil {
+		t.Fatalf("UnmarshalMeasurement(%s) error: %v", data, err)
+	}
+	if r, ok := m.AsRatio(); !ok || r.Value != 3.73 {
+		t.Errorf("AsRatio() = %v, %v", r, ok)
+	}
+}
@@ -0,0 +1,110 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect emitted by JSONSchema
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// unitKeyPattern matches canonical unit keys such as "temperature_celsius"
+const unitKeyPattern = "^[a-z][a-z0-9_-]*$"
+
+// numericStringPattern matches the decimal numbers accepted as quoted values,
+// such as "25" or "-1.5e3"
+const numericStringPattern = `^\s*[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d+)?\s*$`
+
+// JSONSchema returns a JSON Schema document describing measurements
+// serialized in the given format, for validating payloads produced by
+// MarshalWithFormat. Dimensions are restricted to ListDimensions plus
+// "general"; unit keys are only checked for their shape, not looked up.
+// The value may be a number, as written by MarshalWithFormat, or any of the
+// other shapes the unmarshalers accept: a numeric string ("25") or an
+// object {"amount": ...} holding either.
+func JSONSchema(format SerializationFormat) []byte {
+	dimension := map[string]any{
+		"type": "string",
+		"enum": append(ListDimensions(), "general"),
+	}
+	key := map[string]any{
+		"type":    "string",
+		"pattern": unitKeyPattern,
+	}
+	text := map[string]any{"type": "string"}
+
+	var title string
+	var properties map[string]any
+	switch format {
+	case FormatCompact:
+		title = "Compact measurement"
+		properties = map[string]any{
+			"unit": objectSchema(map[string]any{
+				"key":    key,
+				"symbol": text,
+			}),
+		}
+	case FormatMinimal:
+		title = "Minimal measurement"
+		properties = map[string]any{
+			"unit": key,
+		}
+	case FormatCompactWithDimension:
+		title = "Compact measurement with dimension"
+		properties = map[string]any{
+			"unit":      key,
+			"dimension": dimension,
+			"symbol":    text,
+		}
+	case FormatVersioned:
+		title = "Versioned measurement"
+		properties = map[string]any{
+			"v":         map[string]any{"const": FormatVersion},
+			"unit":      key,
+			"dimension": dimension,
+		}
+	default:
+		title = "Full measurement"
+		properties = map[string]any{
+			"unit": objectSchema(map[string]any{
+				"name":      text,
+				"symbol":    text,
+				"dimension": dimension,
+			}),
+		}
+	}
+	number := map[string]any{"type": "number"}
+	numericString := map[string]any{"type": "string", "pattern": numericStringPattern}
+	properties["value"] = map[string]any{
+		"oneOf": []any{
+			number,
+			numericString,
+			objectSchema(map[string]any{
+				"amount": map[string]any{"oneOf": []any{number, numericString}},
+			}),
+		},
+	}
+
+	schema := objectSchema(properties)
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = title
+
+	data, _ := json.MarshalIndent(schema, "", "  ") // maps of plain values always marshal
+	return data
+}
+
+// objectSchema returns a schema for a JSON object requiring all of properties
+func objectSchema(properties map[string]any) map[string]any {
+	required := make([]string, 0, len(properties))
+	for name := range properties {
+		required = append(required, name)
+	}
+	sort.Strings(required)
+	return map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
@@ -0,0 +1,126 @@
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	formats := []SerializationFormat{FormatFull, FormatCompact, FormatMinimal, FormatCompactWithDimension, FormatVersioned}
+
+	for _, format := range formats {
+		var schema struct {
+			Schema     string                     `json:"$schema"`
+			Type       string                     `json:"type"`
+			Required   []string                   `json:"required"`
+			Properties map[string]json.RawMessage `json:"properties"`
+		}
+		data := JSONSchema(format)
+		if err := json.Unmarshal(data, &schema); err != nil {
+			t.Fatalf("format %d: schema is not valid JSON: %v", format, err)
+		}
+		if schema.Schema == "" || schema.Type != "object" {
+			t.Errorf("format %d: $schema = %q, type = %q", format, schema.Schema, schema.Type)
+		}
+		if _, ok := schema.Properties["value"]; !ok {
+			t.Errorf("format %d: missing value property", format)
+		}
+		if _, ok := schema.Properties["unit"]; !ok {
+			t.Errorf("format %d: missing unit property", format)
+		}
+	}
+
+	// The dimension enum sits under unit for the full format and at the top
+	// level for the flat format
+	var full struct {
+		Properties struct {
+			Unit struct {
+				Properties struct {
+					Dimension struct {
+						Enum []string `json:"enum"`
+					} `json:"dimension"`
+				} `json:"properties"`
+			} `json:"unit"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(JSONSchema(FormatFull), &full); err != nil {
+		t.Fatal(err)
+	}
+	var flat struct {
+		Properties struct {
+			Dimension struct {
+				Enum []string `json:"enum"`
+			} `json:"dimension"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(JSONSchema(FormatCompactWithDimension), &flat); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, enum := range map[string][]string{
+		"full": full.Properties.Unit.Properties.Dimension.Enum,
+		"flat": flat.Properties.Dimension.Enum,
+	} {
+		known := make(map[string]bool, len(enum))
+		for _, d := range enum {
+			known[d] = true
+		}
+		for _, d := range append(ListDimensions(), "general") {
+			if !known[d] {
+				t.Errorf("%s schema: dimension enum is missing %q", name, d)
+			}
+		}
+	}
+}
+
+func TestJSONSchemaValueShapes(t *testing.T) {
+	var schema struct {
+		Properties struct {
+			Value struct {
+				OneOf []struct {
+					Type       string                     `json:"type"`
+					Pattern    string                     `json:"pattern"`
+					Properties map[string]json.RawMessage `json:"properties"`
+				} `json:"oneOf"`
+			} `json:"value"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(JSONSchema(FormatCompact), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	shapes := schema.Properties.Value.OneOf
+	if len(shapes) != 3 || shapes[0].Type != "number" || shapes[1].Type != "string" || shapes[2].Type != "object" {
+		t.Fatalf("value oneOf = %+v, expected number, string and object", shapes)
+	}
+	if _, ok := shapes[2].Properties["amount"]; !ok {
+		t.Errorf("value object shape is missing amount")
+	}
+
+	// Strings the pattern admits must be accepted by the unmarshalers, and
+	// strings it rejects must be rejected
+	pattern := regexp.MustCompile(shapes[1].Pattern)
+	testCases := []struct {
+		value    string
+		expected bool
+	}{
+		{"25", true},
+		{"-1.5e3", true},
+		{" .5 ", true},
+		{"abc", false},
+		{"", false},
+	}
+	for _, tc := range testCases {
+		if got := pattern.MatchString(tc.value); got != tc.expected {
+			t.Errorf("pattern match %q = %v, expected %v", tc.value, got, tc.expected)
+		}
+		for _, value := range []string{fmt.Sprintf("%q", tc.value), fmt.Sprintf(`{"amount":%q}`, tc.value)} {
+			payload := fmt.Sprintf(`{"value":%s,"unit":{"key":"length_meter"}}`, value)
+			if _, err := UnmarshalMeasurement([]byte(payload)); (err == nil) != tc.expected {
+				t.Errorf("UnmarshalMeasurement(%s) error = %v, expected accepted = %v", payload, err, tc.expected)
+			}
+		}
+	}
+}
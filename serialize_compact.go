@@ -4,7 +4,6 @@ package unit
 
 import (
 	"encoding/json"
-	"fmt"
 	"strings"
 )
 
@@ -23,6 +22,7 @@ var pressureUnitsByKey = map[string]PressureUnit{
 	"pressure_bar":                    Pressure.Bar,
 	"pressure_pounds_per_square_inch": Pressure.PSI,
 	"pressure_inches_of_water_column": Pressure.InchH2O,
+	"pressure_inches_of_mercury":      Pressure.InchHg,
 }
 
 var lengthUnitsByKey = map[string]LengthUnit{
@@ -48,6 +48,7 @@ var massUnitsByKey = map[string]MassUnit{
 	"mass_stone":      Mass.Stone,
 	"mass_metric_ton": Mass.MetricTon,
 	"mass_ton":        Mass.Ton,
+	"mass_long_ton":   Mass.LongTon,
 }
 
 var durationUnitsByKey = map[string]DurationUnit{
@@ -83,20 +84,23 @@ var areaUnitsByKey = map[string]AreaUnit{
 }
 
 var volumeUnitsByKey = map[string]VolumeUnit{
-	"volume_cubic_meter":      Volume.CubicMeter,
-	"volume_cubic_kilometer":  Volume.CubicKilometer,
-	"volume_cubic_centimeter": Volume.CubicCentimeter,
-	"volume_cubic_millimeter": Volume.CubicMillimeter,
-	"volume_liter":            Volume.Liter,
-	"volume_milliliter":       Volume.Milliliter,
-	"volume_cubic_inch":       Volume.CubicInch,
-	"volume_cubic_foot":       Volume.CubicFoot,
-	"volume_cubic_yard":       Volume.CubicYard,
-	"volume_gallon":           Volume.Gallon,
-	"volume_quart":            Volume.Quart,
-	"volume_pint":             Volume.Pint,
-	"volume_cup":              Volume.Cup,
-	"volume_fluid_ounce":      Volume.FluidOunce,
+	"volume_cubic_meter":          Volume.CubicMeter,
+	"volume_cubic_kilometer":      Volume.CubicKilometer,
+	"volume_cubic_centimeter":     Volume.CubicCentimeter,
+	"volume_cubic_millimeter":     Volume.CubicMillimeter,
+	"volume_liter":                Volume.Liter,
+	"volume_milliliter":           Volume.Milliliter,
+	"volume_cubic_inch":           Volume.CubicInch,
+	"volume_cubic_foot":           Volume.CubicFoot,
+	"volume_cubic_yard":           Volume.CubicYard,
+	"volume_gallon":               Volume.Gallon,
+	"volume_quart":                Volume.Quart,
+	"volume_pint":                 Volume.Pint,
+	"volume_cup":                  Volume.Cup,
+	"volume_fluid_ounce":          Volume.FluidOunce,
+	"volume_imperial_pint":        Volume.ImperialPint,
+	"volume_imperial_fluid_ounce": Volume.ImperialFluidOunce,
+	"volume_board_foot":           Volume.BoardFoot,
 }
 
 var speedUnitsByKey = map[string]SpeedUnit{
@@ -105,30 +109,38 @@ var speedUnitsByKey = map[string]SpeedUnit{
 	"speed_miles_per_hour":      Speed.MilesPerHour,
 	"speed_feet_per_second":     Speed.FeetPerSecond,
 	"speed_knot":                Speed.Knot,
+	"speed_feet_per_minute":     Speed.FeetPerMinute,
 }
 
 var accelerationUnitsByKey = map[string]AccelerationUnit{
 	"acceleration_meters_per_second_squared": Acceleration.MetersPerSecondSquared,
-	"acceleration_g":                         Acceleration.G,
+	"acceleration_g":                         Acceleration.G, // Legacy key
+	"acceleration_g-force":                   Acceleration.G,
 	"acceleration_feet_per_second_squared":   Acceleration.FeetPerSecondSquared,
 }
 
 var flowRateUnitsByKey = map[string]FlowRateUnit{
 	"flowrate_cubic_meters_per_hour": FlowRate.CubicMetersPerHour,
 	"flowrate_liters_per_second":     FlowRate.LitersPerSecond,
-	"flowrate_c_f_m":                 FlowRate.CFM,
+	"flowrate_cubic_feet_per_minute": FlowRate.CFM,
+	"flowrate_c_f_m":                 FlowRate.CFM, // Legacy key
 }
 
 var powerUnitsByKey = map[string]PowerUnit{
-	"power_watt":           Power.Watt,
-	"power_kilowatt":       Power.Kilowatt,
-	"power_b_t_u_per_hour": Power.BTUPerHour,
+	"power_watt":                          Power.Watt,
+	"power_kilowatt":                      Power.Kilowatt,
+	"power_b_t_u_per_hour":                Power.BTUPerHour, // Legacy key
+	"power_british_thermal_unit_per_hour": Power.BTUPerHour,
+	"power_ton_of_refrigeration":          Power.TonRefrigeration,
 }
 
 var energyUnitsByKey = map[string]EnergyUnit{
-	"energy_joule":         Energy.Joule,
-	"energy_kilowatt_hour": Energy.KilowattHour,
-	"energy_b_t_u":         Energy.BTU,
+	"energy_joule":                Energy.Joule,
+	"energy_kilowatt_hour":        Energy.KilowattHour, // Legacy key
+	"energy_kilowatt-hour":        Energy.KilowattHour,
+	"energy_b_t_u":                Energy.BTU, // Legacy key
+	"energy_british_thermal_unit": Energy.BTU,
+	"energy_ton_of_tnt":           Energy.TonTNT,
 }
 
 var concentrationUnitsByKey = map[string]ConcentrationUnit{
@@ -136,6 +148,7 @@ var concentrationUnitsByKey = map[string]ConcentrationUnit{
 	"concentration_milligrams_per_liter": Concentration.MilligramsPerLiter,
 	"concentration_parts_per_million":    Concentration.PartsPerMillion,
 	"concentration_parts_per_billion":    Concentration.PartsPerBillion,
+	"concentration_grains_per_gallon":    Concentration.GrainsPerGallon,
 }
 
 var dispersionUnitsByKey = map[string]DispersionUnit{
@@ -149,8 +162,10 @@ var electricChargeUnitsByKey = map[string]ElectricChargeUnit{
 	"electric_charge_coulomb":           ElectricCharge.Coulomb,
 	"electric_charge_millicoulomb":      ElectricCharge.Millicoulomb,
 	"electric_charge_microcoulomb":      ElectricCharge.Microcoulomb,
-	"electric_charge_ampere__hour":      ElectricCharge.Ampere_Hour,
-	"electric_charge_milliampere__hour": ElectricCharge.Milliampere_Hour,
+	"electric_charge_ampere__hour":      ElectricCharge.Ampere_Hour, // Legacy key
+	"electric_charge_ampere-hour":       ElectricCharge.Ampere_Hour,
+	"electric_charge_milliampere__hour": ElectricCharge.Milliampere_Hour, // Legacy key
+	"electric_charge_milliampere-hour":  ElectricCharge.Milliampere_Hour,
 }
 
 var electricCurrentUnitsByKey = map[string]ElectricCurrentUnit{
@@ -169,17 +184,19 @@ var electricPotentialDifferenceUnitsByKey = map[string]ElectricPotentialDifferen
 }
 
 var frequencyUnitsByKey = map[string]FrequencyUnit{
-	"frequency_hertz":     Frequency.Hertz,
-	"frequency_kilohertz": Frequency.Kilohertz,
-	"frequency_megahertz": Frequency.Megahertz,
-	"frequency_gigahertz": Frequency.Gigahertz,
-	"frequency_terahertz": Frequency.Terahertz,
-	"frequency_r_p_m":     Frequency.RPM,
+	"frequency_hertz":                  Frequency.Hertz,
+	"frequency_kilohertz":              Frequency.Kilohertz,
+	"frequency_megahertz":              Frequency.Megahertz,
+	"frequency_gigahertz":              Frequency.Gigahertz,
+	"frequency_terahertz":              Frequency.Terahertz,
+	"frequency_r_p_m":                  Frequency.RPM, // Legacy key
+	"frequency_revolutions_per_minute": Frequency.RPM,
 }
 
 var illuminanceUnitsByKey = map[string]IlluminanceUnit{
 	"illuminance_lux":         Illuminance.Lux,
-	"illuminance_foot_candle": Illuminance.FootCandle,
+	"illuminance_foot_candle": Illuminance.FootCandle, // Legacy key
+	"illuminance_foot-candle": Illuminance.FootCandle,
 	"illuminance_phot":        Illuminance.Phot,
 	"illuminance_nox":         Illuminance.Nox,
 }
@@ -197,17 +214,73 @@ var informationUnitsByKey = map[string]InformationUnit{
 	"information_gibibyte": Information.Gibibyte,
 	"information_tebibyte": Information.Tebibyte,
 	"information_pebibyte": Information.Pebibyte,
+	"information_kibibit":  Information.Kibibit,
+	"information_mebibit":  Information.Mebibit,
+	"information_gibibit":  Information.Gibibit,
 }
 
 var fuelEfficiencyUnitsByKey = map[string]FuelEfficiencyUnit{
-	"fuel_efficiency_kilometers_per_liter":     FuelEfficiency.KilometersPerLiter,
-	"fuel_efficiency_miles_per_gallon":         FuelEfficiency.MilesPerGallon,
-	"fuel_efficiency_liters_per100_kilometers": FuelEfficiency.LitersPer100Kilometers,
+	"fuel_efficiency_kilometers_per_liter":      FuelEfficiency.KilometersPerLiter,
+	"fuel_efficiency_miles_per_gallon":          FuelEfficiency.MilesPerGallon,
+	"fuel_efficiency_liters_per100_kilometers":  FuelEfficiency.LitersPer100Kilometers, // Legacy key
+	"fuel_efficiency_liters_per_100_kilometers": FuelEfficiency.LitersPer100Kilometers,
+}
+
+var ratioUnitsByKey = map[string]RatioUnit{
+	"ratio_dimensionless": Ratio.Dimensionless,
+}
+
+var specificHeatCapacityUnitsByKey = map[string]SpecificHeatCapacityUnit{
+	"specific_heat_capacity_joules_per_kilogram_kelvin":               SpecificHeatCapacity.JoulesPerKilogramKelvin,
+	"specific_heat_capacity_kilocalories_per_kilogram_degree_celsius": SpecificHeatCapacity.KilocaloriesPerKilogramCelsius,
+}
+
+var heatCapacityUnitsByKey = map[string]HeatCapacityUnit{
+	"heat_capacity_joules_per_kelvin":     HeatCapacity.JoulesPerKelvin,
+	"heat_capacity_kilojoules_per_kelvin": HeatCapacity.KilojoulesPerKelvin,
+	"heat_capacity_calories_per_kelvin":   HeatCapacity.CaloriesPerKelvin,
+}
+
+var linearDensityUnitsByKey = map[string]LinearDensityUnit{
+	"linear_density_kilograms_per_meter": LinearDensity.KilogramsPerMeter,
+	"linear_density_grams_per_meter":     LinearDensity.GramsPerMeter,
+	"linear_density_tex":                 LinearDensity.Tex,
+	"linear_density_denier":              LinearDensity.Denier,
+}
+
+var areaDensityUnitsByKey = map[string]AreaDensityUnit{
+	"area_density_grams_per_square_meter":     AreaDensity.GramsPerSquareMeter,
+	"area_density_kilograms_per_square_meter": AreaDensity.KilogramsPerSquareMeter,
+	"area_density_ounces_per_square_yard":     AreaDensity.OuncesPerSquareYard,
+}
+
+var angularVelocityUnitsByKey = map[string]AngularVelocityUnit{
+	"angular_velocity_radians_per_second": AngularVelocity.RadiansPerSecond,
+	"angular_velocity_degrees_per_second": AngularVelocity.DegreesPerSecond,
+}
+
+var magneticFluxDensityUnitsByKey = map[string]MagneticFluxDensityUnit{
+	"magnetic_flux_density_tesla":      MagneticFluxDensity.Tesla,
+	"magnetic_flux_density_millitesla": MagneticFluxDensity.Millitesla,
+	"magnetic_flux_density_microtesla": MagneticFluxDensity.Microtesla,
+	"magnetic_flux_density_gauss":      MagneticFluxDensity.Gauss,
+}
+
+var electricFieldUnitsByKey = map[string]ElectricFieldUnit{
+	"electric_field_volts_per_meter":      ElectricField.VoltsPerMeter,
+	"electric_field_kilovolts_per_meter":  ElectricField.KilovoltsPerMeter,
+	"electric_field_volts_per_centimeter": ElectricField.VoltsPerCentimeter,
+}
+
+var densityUnitsByKey = map[string]DensityUnit{
+	"density_kilograms_per_cubic_meter":  Density.KilogramsPerCubicMeter,
+	"density_grams_per_cubic_centimeter": Density.GramsPerCubicCentimeter,
+	"density_pounds_per_cubic_foot":      Density.PoundsPerCubicFoot,
 }
 
 // marshalCompactGeneric is a helper function to serialize any measurement to compact JSON
 func marshalCompactGeneric[T Category](m Quantity[T], includeSymbol bool) ([]byte, error) {
-	key := unitKey(m.Unit.Dimension(), m.Unit.Name())
+	key := CanonicalKey(m.Unit)
 	cj := legacyCompactJSON{
 		Value: m.Value,
 		Unit:  key,
@@ -236,7 +309,7 @@ func UnmarshalCompactTemperature(data []byte) (Quantity[TemperatureUnit], error)
 	}
 	unit, ok := temperatureUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[TemperatureUnit]{}, fmt.Errorf("unknown temperature unit key: %s", cj.Unit)
+		return Quantity[TemperatureUnit]{}, kindErrorf(ErrUnknownUnit, "unknown temperature unit key: %s", cj.Unit)
 	}
 	return NewTemperature(cj.Value, unit), nil
 }
@@ -259,7 +332,7 @@ func UnmarshalCompactPressure(data []byte) (Quantity[PressureUnit], error) {
 	}
 	unit, ok := pressureUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[PressureUnit]{}, fmt.Errorf("unknown pressure unit key: %s", cj.Unit)
+		return Quantity[PressureUnit]{}, kindErrorf(ErrUnknownUnit, "unknown pressure unit key: %s", cj.Unit)
 	}
 	return NewPressure(cj.Value, unit), nil
 }
@@ -282,7 +355,7 @@ func UnmarshalCompactLength(data []byte) (Quantity[LengthUnit], error) {
 	}
 	unit, ok := lengthUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[LengthUnit]{}, fmt.Errorf("unknown length unit key: %s", cj.Unit)
+		return Quantity[LengthUnit]{}, kindErrorf(ErrUnknownUnit, "unknown length unit key: %s", cj.Unit)
 	}
 	return NewLength(cj.Value, unit), nil
 }
@@ -305,7 +378,7 @@ func UnmarshalCompactMass(data []byte) (Quantity[MassUnit], error) {
 	}
 	unit, ok := massUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[MassUnit]{}, fmt.Errorf("unknown mass unit key: %s", cj.Unit)
+		return Quantity[MassUnit]{}, kindErrorf(ErrUnknownUnit, "unknown mass unit key: %s", cj.Unit)
 	}
 	return NewMass(cj.Value, unit), nil
 }
@@ -328,7 +401,7 @@ func UnmarshalCompactDuration(data []byte) (Quantity[DurationUnit], error) {
 	}
 	unit, ok := durationUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[DurationUnit]{}, fmt.Errorf("unknown duration unit key: %s", cj.Unit)
+		return Quantity[DurationUnit]{}, kindErrorf(ErrUnknownUnit, "unknown duration unit key: %s", cj.Unit)
 	}
 	return NewDuration(cj.Value, unit), nil
 }
@@ -351,7 +424,7 @@ func UnmarshalCompactAngle(data []byte) (Quantity[AngleUnit], error) {
 	}
 	unit, ok := angleUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[AngleUnit]{}, fmt.Errorf("unknown angle unit key: %s", cj.Unit)
+		return Quantity[AngleUnit]{}, kindErrorf(ErrUnknownUnit, "unknown angle unit key: %s", cj.Unit)
 	}
 	return NewAngle(cj.Value, unit), nil
 }
@@ -374,7 +447,7 @@ func UnmarshalCompactArea(data []byte) (Quantity[AreaUnit], error) {
 	}
 	unit, ok := areaUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[AreaUnit]{}, fmt.Errorf("unknown area unit key: %s", cj.Unit)
+		return Quantity[AreaUnit]{}, kindErrorf(ErrUnknownUnit, "unknown area unit key: %s", cj.Unit)
 	}
 	return NewArea(cj.Value, unit), nil
 }
@@ -397,7 +470,7 @@ func UnmarshalCompactVolume(data []byte) (Quantity[VolumeUnit], error) {
 	}
 	unit, ok := volumeUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[VolumeUnit]{}, fmt.Errorf("unknown volume unit key: %s", cj.Unit)
+		return Quantity[VolumeUnit]{}, kindErrorf(ErrUnknownUnit, "unknown volume unit key: %s", cj.Unit)
 	}
 	return NewVolume(cj.Value, unit), nil
 }
@@ -420,7 +493,7 @@ func UnmarshalCompactSpeed(data []byte) (Quantity[SpeedUnit], error) {
 	}
 	unit, ok := speedUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[SpeedUnit]{}, fmt.Errorf("unknown speed unit key: %s", cj.Unit)
+		return Quantity[SpeedUnit]{}, kindErrorf(ErrUnknownUnit, "unknown speed unit key: %s", cj.Unit)
 	}
 	return NewSpeed(cj.Value, unit), nil
 }
@@ -443,7 +516,7 @@ func UnmarshalCompactAcceleration(data []byte) (Quantity[AccelerationUnit], erro
 	}
 	unit, ok := accelerationUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[AccelerationUnit]{}, fmt.Errorf("unknown acceleration unit key: %s", cj.Unit)
+		return Quantity[AccelerationUnit]{}, kindErrorf(ErrUnknownUnit, "unknown acceleration unit key: %s", cj.Unit)
 	}
 	return NewAcceleration(cj.Value, unit), nil
 }
@@ -466,7 +539,7 @@ func UnmarshalCompactFlowRate(data []byte) (Quantity[FlowRateUnit], error) {
 	}
 	unit, ok := flowRateUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[FlowRateUnit]{}, fmt.Errorf("unknown flowrate unit key: %s", cj.Unit)
+		return Quantity[FlowRateUnit]{}, kindErrorf(ErrUnknownUnit, "unknown flowrate unit key: %s", cj.Unit)
 	}
 	return NewFlowRate(cj.Value, unit), nil
 }
@@ -489,7 +562,7 @@ func UnmarshalCompactPower(data []byte) (Quantity[PowerUnit], error) {
 	}
 	unit, ok := powerUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[PowerUnit]{}, fmt.Errorf("unknown power unit key: %s", cj.Unit)
+		return Quantity[PowerUnit]{}, kindErrorf(ErrUnknownUnit, "unknown power unit key: %s", cj.Unit)
 	}
 	return NewPower(cj.Value, unit), nil
 }
@@ -512,7 +585,7 @@ func UnmarshalCompactEnergy(data []byte) (Quantity[EnergyUnit], error) {
 	}
 	unit, ok := energyUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[EnergyUnit]{}, fmt.Errorf("unknown energy unit key: %s", cj.Unit)
+		return Quantity[EnergyUnit]{}, kindErrorf(ErrUnknownUnit, "unknown energy unit key: %s", cj.Unit)
 	}
 	return NewEnergy(cj.Value, unit), nil
 }
@@ -535,7 +608,7 @@ func UnmarshalCompactConcentration(data []byte) (Quantity[ConcentrationUnit], er
 	}
 	unit, ok := concentrationUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[ConcentrationUnit]{}, fmt.Errorf("unknown concentration unit key: %s", cj.Unit)
+		return Quantity[ConcentrationUnit]{}, kindErrorf(ErrUnknownUnit, "unknown concentration unit key: %s", cj.Unit)
 	}
 	return NewConcentration(cj.Value, unit), nil
 }
@@ -558,7 +631,7 @@ func UnmarshalCompactDispersion(data []byte) (Quantity[DispersionUnit], error) {
 	}
 	unit, ok := dispersionUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[DispersionUnit]{}, fmt.Errorf("unknown dispersion unit key: %s", cj.Unit)
+		return Quantity[DispersionUnit]{}, kindErrorf(ErrUnknownUnit, "unknown dispersion unit key: %s", cj.Unit)
 	}
 	return NewDispersion(cj.Value, unit), nil
 }
@@ -581,7 +654,7 @@ func UnmarshalCompactElectricCharge(data []byte) (Quantity[ElectricChargeUnit],
 	}
 	unit, ok := electricChargeUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[ElectricChargeUnit]{}, fmt.Errorf("unknown electric_charge unit key: %s", cj.Unit)
+		return Quantity[ElectricChargeUnit]{}, kindErrorf(ErrUnknownUnit, "unknown electric_charge unit key: %s", cj.Unit)
 	}
 	return NewElectricCharge(cj.Value, unit), nil
 }
@@ -604,7 +677,7 @@ func UnmarshalCompactElectricCurrent(data []byte) (Quantity[ElectricCurrentUnit]
 	}
 	unit, ok := electricCurrentUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[ElectricCurrentUnit]{}, fmt.Errorf("unknown electric_current unit key: %s", cj.Unit)
+		return Quantity[ElectricCurrentUnit]{}, kindErrorf(ErrUnknownUnit, "unknown electric_current unit key: %s", cj.Unit)
 	}
 	return NewElectricCurrent(cj.Value, unit), nil
 }
@@ -627,7 +700,7 @@ func UnmarshalCompactElectricPotentialDifference(data []byte) (Quantity[Electric
 	}
 	unit, ok := electricPotentialDifferenceUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[ElectricPotentialDifferenceUnit]{}, fmt.Errorf("unknown electric_potential_difference unit key: %s", cj.Unit)
+		return Quantity[ElectricPotentialDifferenceUnit]{}, kindErrorf(ErrUnknownUnit, "unknown electric_potential_difference unit key: %s", cj.Unit)
 	}
 	return NewElectricPotentialDifference(cj.Value, unit), nil
 }
@@ -650,7 +723,7 @@ func UnmarshalCompactFrequency(data []byte) (Quantity[FrequencyUnit], error) {
 	}
 	unit, ok := frequencyUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[FrequencyUnit]{}, fmt.Errorf("unknown frequency unit key: %s", cj.Unit)
+		return Quantity[FrequencyUnit]{}, kindErrorf(ErrUnknownUnit, "unknown frequency unit key: %s", cj.Unit)
 	}
 	return NewFrequency(cj.Value, unit), nil
 }
@@ -673,7 +746,7 @@ func UnmarshalCompactIlluminance(data []byte) (Quantity[IlluminanceUnit], error)
 	}
 	unit, ok := illuminanceUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[IlluminanceUnit]{}, fmt.Errorf("unknown illuminance unit key: %s", cj.Unit)
+		return Quantity[IlluminanceUnit]{}, kindErrorf(ErrUnknownUnit, "unknown illuminance unit key: %s", cj.Unit)
 	}
 	return NewIlluminance(cj.Value, unit), nil
 }
@@ -696,7 +769,7 @@ func UnmarshalCompactInformation(data []byte) (Quantity[InformationUnit], error)
 	}
 	unit, ok := informationUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[InformationUnit]{}, fmt.Errorf("unknown information unit key: %s", cj.Unit)
+		return Quantity[InformationUnit]{}, kindErrorf(ErrUnknownUnit, "unknown information unit key: %s", cj.Unit)
 	}
 	return NewInformation(cj.Value, unit), nil
 }
@@ -719,11 +792,218 @@ func UnmarshalCompactFuelEfficiency(data []byte) (Quantity[FuelEfficiencyUnit],
 	}
 	unit, ok := fuelEfficiencyUnitsByKey[cj.Unit]
 	if !ok {
-		return Quantity[FuelEfficiencyUnit]{}, fmt.Errorf("unknown fuel_efficiency unit key: %s", cj.Unit)
+		return Quantity[FuelEfficiencyUnit]{}, kindErrorf(ErrUnknownUnit, "unknown fuel_efficiency unit key: %s", cj.Unit)
 	}
 	return NewFuelEfficiency(cj.Value, unit), nil
 }
 
+// MarshalCompactRatio serializes a Ratio measurement to compact JSON
+func MarshalCompactRatio(m Quantity[RatioUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactRatioWithSymbol serializes a Ratio measurement to compact JSON with symbol
+func MarshalCompactRatioWithSymbol(m Quantity[RatioUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactRatio deserializes compact JSON to a Ratio measurement
+func UnmarshalCompactRatio(data []byte) (Quantity[RatioUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[RatioUnit]{}, err
+	}
+	unit, ok := ratioUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[RatioUnit]{}, kindErrorf(ErrUnknownUnit, "unknown ratio unit key: %s", cj.Unit)
+	}
+	return NewRatio(cj.Value, unit), nil
+}
+
+// MarshalCompactDensity serializes a Density measurement to compact JSON
+func MarshalCompactDensity(m Quantity[DensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactDensityWithSymbol serializes a Density measurement to compact JSON with symbol
+func MarshalCompactDensityWithSymbol(m Quantity[DensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactDensity deserializes compact JSON to a Density measurement
+func UnmarshalCompactDensity(data []byte) (Quantity[DensityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[DensityUnit]{}, err
+	}
+	unit, ok := densityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[DensityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown density unit key: %s", cj.Unit)
+	}
+	return NewDensity(cj.Value, unit), nil
+}
+
+// MarshalCompactElectricField serializes an ElectricField measurement to compact JSON
+func MarshalCompactElectricField(m Quantity[ElectricFieldUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactElectricFieldWithSymbol serializes an ElectricField measurement to compact JSON with symbol
+func MarshalCompactElectricFieldWithSymbol(m Quantity[ElectricFieldUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactElectricField deserializes compact JSON to an ElectricField measurement
+func UnmarshalCompactElectricField(data []byte) (Quantity[ElectricFieldUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[ElectricFieldUnit]{}, err
+	}
+	unit, ok := electricFieldUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[ElectricFieldUnit]{}, kindErrorf(ErrUnknownUnit, "unknown electric_field unit key: %s", cj.Unit)
+	}
+	return NewElectricField(cj.Value, unit), nil
+}
+
+// MarshalCompactMagneticFluxDensity serializes a MagneticFluxDensity measurement to compact JSON
+func MarshalCompactMagneticFluxDensity(m Quantity[MagneticFluxDensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactMagneticFluxDensityWithSymbol serializes a MagneticFluxDensity measurement to compact JSON with symbol
+func MarshalCompactMagneticFluxDensityWithSymbol(m Quantity[MagneticFluxDensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactMagneticFluxDensity deserializes compact JSON to a MagneticFluxDensity measurement
+func UnmarshalCompactMagneticFluxDensity(data []byte) (Quantity[MagneticFluxDensityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[MagneticFluxDensityUnit]{}, err
+	}
+	unit, ok := magneticFluxDensityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[MagneticFluxDensityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown magnetic_flux_density unit key: %s", cj.Unit)
+	}
+	return NewMagneticFluxDensity(cj.Value, unit), nil
+}
+
+// MarshalCompactAngularVelocity serializes an AngularVelocity measurement to compact JSON
+func MarshalCompactAngularVelocity(m Quantity[AngularVelocityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactAngularVelocityWithSymbol serializes an AngularVelocity measurement to compact JSON with symbol
+func MarshalCompactAngularVelocityWithSymbol(m Quantity[AngularVelocityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactAngularVelocity deserializes compact JSON to an AngularVelocity measurement
+func UnmarshalCompactAngularVelocity(data []byte) (Quantity[AngularVelocityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[AngularVelocityUnit]{}, err
+	}
+	unit, ok := angularVelocityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[AngularVelocityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown angular_velocity unit key: %s", cj.Unit)
+	}
+	return NewAngularVelocity(cj.Value, unit), nil
+}
+
+// MarshalCompactAreaDensity serializes an AreaDensity measurement to compact JSON
+func MarshalCompactAreaDensity(m Quantity[AreaDensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactAreaDensityWithSymbol serializes an AreaDensity measurement to compact JSON with symbol
+func MarshalCompactAreaDensityWithSymbol(m Quantity[AreaDensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactAreaDensity deserializes compact JSON to an AreaDensity measurement
+func UnmarshalCompactAreaDensity(data []byte) (Quantity[AreaDensityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[AreaDensityUnit]{}, err
+	}
+	unit, ok := areaDensityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[AreaDensityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown area_density unit key: %s", cj.Unit)
+	}
+	return NewAreaDensity(cj.Value, unit), nil
+}
+
+// MarshalCompactLinearDensity serializes a LinearDensity measurement to compact JSON
+func MarshalCompactLinearDensity(m Quantity[LinearDensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactLinearDensityWithSymbol serializes a LinearDensity measurement to compact JSON with symbol
+func MarshalCompactLinearDensityWithSymbol(m Quantity[LinearDensityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactLinearDensity deserializes compact JSON to a LinearDensity measurement
+func UnmarshalCompactLinearDensity(data []byte) (Quantity[LinearDensityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[LinearDensityUnit]{}, err
+	}
+	unit, ok := linearDensityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[LinearDensityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown linear_density unit key: %s", cj.Unit)
+	}
+	return NewLinearDensity(cj.Value, unit), nil
+}
+
+// MarshalCompactHeatCapacity serializes a HeatCapacity measurement to compact JSON
+func MarshalCompactHeatCapacity(m Quantity[HeatCapacityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactHeatCapacityWithSymbol serializes a HeatCapacity measurement to compact JSON with symbol
+func MarshalCompactHeatCapacityWithSymbol(m Quantity[HeatCapacityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactHeatCapacity deserializes compact JSON to a HeatCapacity measurement
+func UnmarshalCompactHeatCapacity(data []byte) (Quantity[HeatCapacityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[HeatCapacityUnit]{}, err
+	}
+	unit, ok := heatCapacityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[HeatCapacityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown heat_capacity unit key: %s", cj.Unit)
+	}
+	return NewHeatCapacity(cj.Value, unit), nil
+}
+
+// MarshalCompactSpecificHeatCapacity serializes a SpecificHeatCapacity measurement to compact JSON
+func MarshalCompactSpecificHeatCapacity(m Quantity[SpecificHeatCapacityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, false)
+}
+
+// MarshalCompactSpecificHeatCapacityWithSymbol serializes a SpecificHeatCapacity measurement to compact JSON with symbol
+func MarshalCompactSpecificHeatCapacityWithSymbol(m Quantity[SpecificHeatCapacityUnit]) ([]byte, error) {
+	return marshalCompactGeneric(m, true)
+}
+
+// UnmarshalCompactSpecificHeatCapacity deserializes compact JSON to a SpecificHeatCapacity measurement
+func UnmarshalCompactSpecificHeatCapacity(data []byte) (Quantity[SpecificHeatCapacityUnit], error) {
+	var cj legacyCompactJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return Quantity[SpecificHeatCapacityUnit]{}, err
+	}
+	unit, ok := specificHeatCapacityUnitsByKey[cj.Unit]
+	if !ok {
+		return Quantity[SpecificHeatCapacityUnit]{}, kindErrorf(ErrUnknownUnit, "unknown specific_heat_capacity unit key: %s", cj.Unit)
+	}
+	return NewSpecificHeatCapacity(cj.Value, unit), nil
+}
+
 // MarshalCompactGeneral serializes a General measurement to compact JSON
 func MarshalCompactGeneral(m Quantity[GeneralUnit]) ([]byte, error) {
 	return marshalCompactGeneric(m, false)
@@ -748,7 +1028,7 @@ func UnmarshalCompactGeneral(data []byte) (Quantity[GeneralUnit], error) {
 		return NewGeneral(cj.Value, General.Percent), nil
 	default:
 		// For custom units, create from the key
-		_, unitName := parseUnitKey(cj.Unit)
+		_, unitName := splitUnitKey(cj.Unit)
 		unit := NewGeneralUnit(unitName, unitName)
 		return NewGeneral(cj.Value, unit), nil
 	}
@@ -762,7 +1042,7 @@ func unmarshalCompactMeasurement(data []byte) (*AnyMeasurement, error) {
 	}
 
 	// Extract dimension from the unit key
-	dimension, _ := parseUnitKey(cj.Unit)
+	dimension, _ := splitUnitKey(cj.Unit)
 
 	switch dimension {
 	case "temperature":
@@ -897,6 +1177,60 @@ func unmarshalCompactMeasurement(data []byte) (*AnyMeasurement, error) {
 			return nil, err
 		}
 		return &AnyMeasurement{value: m, dimension: "fuel_efficiency"}, nil
+	case "ratio":
+		m, err := UnmarshalCompactRatio(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "ratio"}, nil
+	case "density":
+		m, err := UnmarshalCompactDensity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "density"}, nil
+	case "electric_field":
+		m, err := UnmarshalCompactElectricField(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "electric_field"}, nil
+	case "magnetic_flux_density":
+		m, err := UnmarshalCompactMagneticFluxDensity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "magnetic_flux_density"}, nil
+	case "angular_velocity":
+		m, err := UnmarshalCompactAngularVelocity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "angular_velocity"}, nil
+	case "area_density":
+		m, err := UnmarshalCompactAreaDensity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "area_density"}, nil
+	case "linear_density":
+		m, err := UnmarshalCompactLinearDensity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "linear_density"}, nil
+	case "heat_capacity":
+		m, err := UnmarshalCompactHeatCapacity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "heat_capacity"}, nil
+	case "specific_heat_capacity":
+		m, err := UnmarshalCompactSpecificHeatCapacity(data)
+		if err != nil {
+			return nil, err
+		}
+		return &AnyMeasurement{value: m, dimension: "specific_heat_capacity"}, nil
 	case "general":
 		m, err := UnmarshalCompactGeneral(data)
 		if err != nil {
@@ -2,6 +2,7 @@ package unit
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -14,8 +15,10 @@ func TestToSnakeCase(t *testing.T) {
 		{"Fahrenheit", "fahrenheit"},
 		{"KilometersPerHour", "kilometers_per_hour"},
 		{"MetersPerSecondSquared", "meters_per_second_squared"},
-		{"BTU", "b_t_u"},
-		{"PSI", "p_s_i"},
+		{"BTU", "btu"},
+		{"PSI", "psi"},
+		{"BTUPerHour", "btu_per_hour"},
+		{"CFM", "cfm"},
 		{"LitersPer100Kilometers", "liters_per100_kilometers"},
 	}
 
@@ -459,3 +462,218 @@ func TestMarshalCompactAllDimensions(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalKey(t *testing.T) {
+	if got := CanonicalKey(Power.BTUPerHour); got != "power_british_thermal_unit_per_hour" {
+		t.Errorf("CanonicalKey(Power.BTUPerHour) = %q", got)
+	}
+
+	// The key matches what every marshaler emits
+	btu := NewPower(1200, Power.BTUPerHour)
+	minimal, _ := MarshalWithFormat(btu, FormatMinimal)
+	compact, _ := MarshalCompactPower(btu)
+	wrapped, _ := json.Marshal(Compact[PowerUnit]{btu})
+	for _, data := range [][]byte{minimal, compact, wrapped} {
+		if !strings.Contains(string(data), `"power_british_thermal_unit_per_hour"`) {
+			t.Errorf("Marshaled %s does not contain the canonical key", data)
+		}
+	}
+}
+
+// TestCanonicalKeysAreStable pins the key of every registered unit. Stored
+// compact and minimal payloads depend on these keys, so a failure here means
+// a unit rename would break existing data.
+func TestCanonicalKeysAreStable(t *testing.T) {
+	expected := []struct {
+		dimension string
+		symbol    string
+		key       string
+	}{
+		{"acceleration", "ft/s²", "acceleration_feet_per_second_squared"},
+		{"acceleration", "m/s²", "acceleration_meters_per_second_squared"},
+		{"acceleration", "g", "acceleration_g-force"},
+		{"angle", "″", "angle_arcsecond"},
+		{"angle", "′", "angle_arcminute"},
+		{"angle", "grad", "angle_gradian"},
+		{"angle", "°", "angle_degree"},
+		{"angle", "rad", "angle_radian"},
+		{"angle", "rev", "angle_revolution"},
+		{"angular_velocity", "°/s", "angular_velocity_degrees_per_second"},
+		{"angular_velocity", "rad/s", "angular_velocity_radians_per_second"},
+		{"area", "mm²", "area_square_millimeter"},
+		{"area", "cm²", "area_square_centimeter"},
+		{"area", "in²", "area_square_inch"},
+		{"area", "ft²", "area_square_foot"},
+		{"area", "yd²", "area_square_yard"},
+		{"area", "m²", "area_square_meter"},
+		{"area", "ac", "area_acre"},
+		{"area", "ha", "area_hectare"},
+		{"area", "km²", "area_square_kilometer"},
+		{"area", "mi²", "area_square_mile"},
+		{"area_density", "g/m²", "area_density_grams_per_square_meter"},
+		{"area_density", "oz/yd²", "area_density_ounces_per_square_yard"},
+		{"area_density", "kg/m²", "area_density_kilograms_per_square_meter"},
+		{"concentration", "ppb", "concentration_parts_per_billion"},
+		{"concentration", "mg/L", "concentration_milligrams_per_liter"},
+		{"concentration", "ppm", "concentration_parts_per_million"},
+		{"concentration", "gpg", "concentration_grains_per_gallon"},
+		{"concentration", "g/L", "concentration_grams_per_liter"},
+		{"density", "kg/m³", "density_kilograms_per_cubic_meter"},
+		{"density", "lb/ft³", "density_pounds_per_cubic_foot"},
+		{"density", "g/cm³", "density_grams_per_cubic_centimeter"},
+		{"dispersion", "ppt", "dispersion_parts_per_trillion"},
+		{"dispersion", "ppb", "dispersion_parts_per_billion"},
+		{"dispersion", "ppm", "dispersion_parts_per_million"},
+		{"dispersion", "%", "dispersion_percent"},
+		{"duration", "ns", "duration_nanosecond"},
+		{"duration", "µs", "duration_microsecond"},
+		{"duration", "ms", "duration_millisecond"},
+		{"duration", "s", "duration_second"},
+		{"duration", "min", "duration_minute"},
+		{"duration", "h", "duration_hour"},
+		{"duration", "d", "duration_day"},
+		{"electric_charge", "µC", "electric_charge_microcoulomb"},
+		{"electric_charge", "mC", "electric_charge_millicoulomb"},
+		{"electric_charge", "C", "electric_charge_coulomb"},
+		{"electric_charge", "mAh", "electric_charge_milliampere-hour"},
+		{"electric_charge", "Ah", "electric_charge_ampere-hour"},
+		{"electric_current", "µA", "electric_current_microampere"},
+		{"electric_current", "mA", "electric_current_milliampere"},
+		{"electric_current", "A", "electric_current_ampere"},
+		{"electric_current", "kA", "electric_current_kiloampere"},
+		{"electric_field", "V/m", "electric_field_volts_per_meter"},
+		{"electric_field", "V/cm", "electric_field_volts_per_centimeter"},
+		{"electric_field", "kV/m", "electric_field_kilovolts_per_meter"},
+		{"electric_potential_difference", "µV", "electric_potential_difference_microvolt"},
+		{"electric_potential_difference", "mV", "electric_potential_difference_millivolt"},
+		{"electric_potential_difference", "V", "electric_potential_difference_volt"},
+		{"electric_potential_difference", "kV", "electric_potential_difference_kilovolt"},
+		{"electric_potential_difference", "MV", "electric_potential_difference_megavolt"},
+		{"energy", "J", "energy_joule"},
+		{"energy", "BTU", "energy_british_thermal_unit"},
+		{"energy", "kWh", "energy_kilowatt-hour"},
+		{"energy", "tTNT", "energy_ton_of_tnt"},
+		{"flowrate", "m³/h", "flowrate_cubic_meters_per_hour"},
+		{"flowrate", "CFM", "flowrate_cubic_feet_per_minute"},
+		{"flowrate", "L/s", "flowrate_liters_per_second"},
+		{"frequency", "rpm", "frequency_revolutions_per_minute"},
+		{"frequency", "Hz", "frequency_hertz"},
+		{"frequency", "kHz", "frequency_kilohertz"},
+		{"frequency", "MHz", "frequency_megahertz"},
+		{"frequency", "GHz", "frequency_gigahertz"},
+		{"frequency", "THz", "frequency_terahertz"},
+		{"fuel_efficiency", "L/100km", "fuel_efficiency_liters_per_100_kilometers"},
+		{"fuel_efficiency", "mpg", "fuel_efficiency_miles_per_gallon"},
+		{"fuel_efficiency", "km/L", "fuel_efficiency_kilometers_per_liter"},
+		{"heat_capacity", "J/K", "heat_capacity_joules_per_kelvin"},
+		{"heat_capacity", "cal/K", "heat_capacity_calories_per_kelvin"},
+		{"heat_capacity", "kJ/K", "heat_capacity_kilojoules_per_kelvin"},
+		{"illuminance", "nx", "illuminance_nox"},
+		{"illuminance", "lx", "illuminance_lux"},
+		{"illuminance", "fc", "illuminance_foot-candle"},
+		{"illuminance", "ph", "illuminance_phot"},
+		{"information", "bit", "information_bit"},
+		{"information", "B", "information_byte"},
+		{"information", "KB", "information_kilobyte"},
+		{"information", "KiB", "information_kibibyte"},
+		{"information", "MB", "information_megabyte"},
+		{"information", "MiB", "information_mebibyte"},
+		{"information", "GB", "information_gigabyte"},
+		{"information", "GiB", "information_gibibyte"},
+		{"information", "TB", "information_terabyte"},
+		{"information", "TiB", "information_tebibyte"},
+		{"information", "PB", "information_petabyte"},
+		{"information", "PiB", "information_pebibyte"},
+		{"information", "Kibit", "information_kibibit"},
+		{"information", "Mibit", "information_mebibit"},
+		{"information", "Gibit", "information_gibibit"},
+		{"length", "nm", "length_nanometer"},
+		{"length", "µm", "length_micrometer"},
+		{"length", "mm", "length_millimeter"},
+		{"length", "cm", "length_centimeter"},
+		{"length", "in", "length_inch"},
+		{"length", "ft", "length_foot"},
+		{"length", "yd", "length_yard"},
+		{"length", "m", "length_meter"},
+		{"length", "km", "length_kilometer"},
+		{"length", "mi", "length_mile"},
+		{"linear_density", "den", "linear_density_denier"},
+		{"linear_density", "tex", "linear_density_tex"},
+		{"linear_density", "g/m", "linear_density_grams_per_meter"},
+		{"linear_density", "kg/m", "linear_density_kilograms_per_meter"},
+		{"magnetic_flux_density", "µT", "magnetic_flux_density_microtesla"},
+		{"magnetic_flux_density", "G", "magnetic_flux_density_gauss"},
+		{"magnetic_flux_density", "mT", "magnetic_flux_density_millitesla"},
+		{"magnetic_flux_density", "T", "magnetic_flux_density_tesla"},
+		{"mass", "µg", "mass_microgram"},
+		{"mass", "mg", "mass_milligram"},
+		{"mass", "g", "mass_gram"},
+		{"mass", "oz", "mass_ounce"},
+		{"mass", "lb", "mass_pound"},
+		{"mass", "kg", "mass_kilogram"},
+		{"mass", "st", "mass_stone"},
+		{"mass", "ton", "mass_ton"},
+		{"mass", "t", "mass_metric_ton"},
+		{"mass", "long ton", "mass_long_ton"},
+		{"power", "BTU/h", "power_british_thermal_unit_per_hour"},
+		{"power", "W", "power_watt"},
+		{"power", "kW", "power_kilowatt"},
+		{"power", "RT", "power_ton_of_refrigeration"},
+		{"pressure", "Pa", "pressure_pascal"},
+		{"pressure", "inH₂O", "pressure_inches_of_water_column"},
+		{"pressure", "kPa", "pressure_kilopascal"},
+		{"pressure", "inHg", "pressure_inches_of_mercury"},
+		{"pressure", "psi", "pressure_pounds_per_square_inch"},
+		{"pressure", "bar", "pressure_bar"},
+		{"ratio", ":1", "ratio_dimensionless"},
+		{"specific_heat_capacity", "J/(kg·K)", "specific_heat_capacity_joules_per_kilogram_kelvin"},
+		{"specific_heat_capacity", "kcal/(kg·°C)", "specific_heat_capacity_kilocalories_per_kilogram_degree_celsius"},
+		{"speed", "fpm", "speed_feet_per_minute"},
+		{"speed", "km/h", "speed_kilometers_per_hour"},
+		{"speed", "ft/s", "speed_feet_per_second"},
+		{"speed", "mph", "speed_miles_per_hour"},
+		{"speed", "kn", "speed_knot"},
+		{"speed", "m/s", "speed_meters_per_second"},
+		{"temperature", "°F", "temperature_fahrenheit"},
+		{"temperature", "K", "temperature_kelvin"},
+		{"temperature", "°C", "temperature_celsius"},
+		{"volume", "mm³", "volume_cubic_millimeter"},
+		{"volume", "cm³", "volume_cubic_centimeter"},
+		{"volume", "mL", "volume_milliliter"},
+		{"volume", "in³", "volume_cubic_inch"},
+		{"volume", "imp fl oz", "volume_imperial_fluid_ounce"},
+		{"volume", "fl oz", "volume_fluid_ounce"},
+		{"volume", "cup", "volume_cup"},
+		{"volume", "pt", "volume_pint"},
+		{"volume", "imp pt", "volume_imperial_pint"},
+		{"volume", "qt", "volume_quart"},
+		{"volume", "L", "volume_liter"},
+		{"volume", "bf", "volume_board_foot"},
+		{"volume", "gal", "volume_gallon"},
+		{"volume", "ft³", "volume_cubic_foot"},
+		{"volume", "yd³", "volume_cubic_yard"},
+		{"volume", "m³", "volume_cubic_meter"},
+		{"volume", "km³", "volume_cubic_kilometer"},
+	}
+
+	seen := make(map[string]bool)
+	for _, e := range expected {
+		u, err := lookupUnit[Category](e.dimension, e.symbol)
+		if err != nil {
+			t.Errorf("lookupUnit(%q, %q): %v", e.dimension, e.symbol, err)
+			continue
+		}
+		if got := CanonicalKey(u); got != e.key {
+			t.Errorf("CanonicalKey(%s) = %q, expected %q", e.symbol, got, e.key)
+		}
+		seen[e.dimension+"/"+e.symbol] = true
+	}
+
+	for _, dim := range ListDimensions() {
+		for _, u := range registeredUnits(dim) {
+			if !seen[dim+"/"+u.Symbol()] {
+				t.Errorf("Unit %s/%s (key %q) is missing from the pinned keys", dim, u.Symbol(), CanonicalKey(u))
+			}
+		}
+	}
+}
@@ -13,6 +13,7 @@ func TestUnmarshalMeasurementWithGeneralFallback(t *testing.T) {
 		expectedValue      float64
 		expectedUnitSymbol string
 		expectedDimension  string
+		expectedOriginal   string
 	}{
 		{
 			name:               "Unknown dimension should fall back to general",
@@ -20,6 +21,15 @@ func TestUnmarshalMeasurementWithGeneralFallback(t *testing.T) {
 			expectedValue:      42,
 			expectedUnitSymbol: "custom",
 			expectedDimension:  "general",
+			expectedOriginal:   "unknown_dimension",
+		},
+		{
+			name:               "Unsupported dimension keeps its original name",
+			jsonInput:          `{"value": 12, "unit": {"name": "Newton Meter", "symbol": "N·m"}, "dimension": "torque"}`,
+			expectedValue:      12,
+			expectedUnitSymbol: "N·m",
+			expectedDimension:  "general",
+			expectedOriginal:   "torque",
 		},
 		{
 			name:               "Known dimension but unknown unit should fall back to general",
@@ -27,6 +37,7 @@ func TestUnmarshalMeasurementWithGeneralFallback(t *testing.T) {
 			expectedValue:      25,
 			expectedUnitSymbol: "unknown_unit",
 			expectedDimension:  "general",
+			expectedOriginal:   "temperature",
 		},
 		{
 			name:               "Direct general dimension should work normally",
@@ -34,6 +45,7 @@ func TestUnmarshalMeasurementWithGeneralFallback(t *testing.T) {
 			expectedValue:      100,
 			expectedUnitSymbol: "unit",
 			expectedDimension:  "general",
+			expectedOriginal:   "",
 		},
 	}
 
@@ -51,6 +63,10 @@ func TestUnmarshalMeasurementWithGeneralFallback(t *testing.T) {
 				t.Errorf("Expected dimension to be '%s', got '%s'",
 					tc.expectedDimension, anyM.GetDimension())
 			}
+			if anyM.OriginalDimension() != tc.expectedOriginal {
+				t.Errorf("Expected original dimension to be '%s', got '%s'",
+					tc.expectedOriginal, anyM.OriginalDimension())
+			}
 
 			// Check if we can get it as a general measurement
 			generalM, ok := anyM.AsGeneral()
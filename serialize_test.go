@@ -2,6 +2,10 @@ package unit
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -283,3 +287,798 @@ func TestGenericUnmarshalMeasurement(t *testing.T) {
 		t.Errorf("Expected conversion from temperature to length to fail, but it succeeded")
 	}
 }
+
+func TestMarshalWithFormatASCII(t *testing.T) {
+	t.Run("Temperature", func(t *testing.T) {
+		temp := NewTemperature(25.0, Temperature.Celsius)
+		data, err := MarshalWithFormatASCII(temp, FormatFull)
+		if err != nil {
+			t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+		}
+
+		var full MeasurementJSON
+		if err := json.Unmarshal(data, &full); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+		if full.Unit.Symbol != "degC" {
+			t.Errorf("Expected ASCII symbol degC, got %s", full.Unit.Symbol)
+		}
+
+		temp2, err := UnmarshalTemperature(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal temperature: %v", err)
+		}
+		if !temp.Equal(temp2) || !temp2.Unit.Equals(Temperature.Celsius) {
+			t.Errorf("Round-trip serialization failed: got %v, expected %v", temp2, temp)
+		}
+	})
+
+	t.Run("Volume", func(t *testing.T) {
+		volume := NewVolume(2.5, Volume.CubicMeter)
+		data, err := MarshalWithFormatASCII(volume, FormatCompact)
+		if err != nil {
+			t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+		}
+
+		var compact MeasurementCompactJSON
+		if err := json.Unmarshal(data, &compact); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+		if compact.Unit.Symbol != "m3" {
+			t.Errorf("Expected ASCII symbol m3, got %s", compact.Unit.Symbol)
+		}
+
+		volume2, err := UnmarshalVolume(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal volume: %v", err)
+		}
+		if !volume.Equal(volume2) || !volume2.Unit.Equals(Volume.CubicMeter) {
+			t.Errorf("Round-trip serialization failed: got %v, expected %v", volume2, volume)
+		}
+	})
+
+	t.Run("Mass", func(t *testing.T) {
+		mass := NewMass(150.0, Mass.Microgram)
+		data, err := MarshalWithFormatASCII(mass, FormatFull)
+		if err != nil {
+			t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+		}
+		for _, b := range data {
+			if b > 127 {
+				t.Fatalf("Expected ASCII-only output, got %s", data)
+			}
+		}
+
+		anyM, err := UnmarshalMeasurement(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal measurement: %v", err)
+		}
+		mass2, ok := anyM.AsMass()
+		if !ok {
+			t.Fatalf("Expected mass, got dimension %s", anyM.GetDimension())
+		}
+		if !mass2.Unit.Equals(Mass.Microgram) || mass2.Value != 150.0 {
+			t.Errorf("Round-trip serialization failed: got %v, expected %v", mass2, mass)
+		}
+	})
+
+	t.Run("Density", func(t *testing.T) {
+		testCases := []struct {
+			unit     DensityUnit
+			expected string
+		}{
+			{Density.KilogramsPerCubicMeter, "kg/m3"},
+			{Density.GramsPerCubicCentimeter, "g/cm3"},
+			{Density.PoundsPerCubicFoot, "lb/ft3"},
+		}
+		for _, tc := range testCases {
+			density := NewDensity(997, tc.unit)
+			data, err := MarshalWithFormatASCII(density, FormatFull)
+			if err != nil {
+				t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+			}
+
+			var full MeasurementJSON
+			if err := json.Unmarshal(data, &full); err != nil {
+				t.Fatalf("Failed to parse JSON: %v", err)
+			}
+			if full.Unit.Symbol != tc.expected {
+				t.Errorf("Expected ASCII symbol %s, got %s", tc.expected, full.Unit.Symbol)
+			}
+
+			density2, err := UnmarshalDensity(data)
+			if err != nil {
+				t.Fatalf("Failed to unmarshal density: %v", err)
+			}
+			if !density.Equal(density2) || !density2.Unit.Equals(tc.unit) {
+				t.Errorf("Round-trip serialization failed: got %v, expected %v", density2, density)
+			}
+		}
+	})
+
+	t.Run("MagneticFluxDensity", func(t *testing.T) {
+		field := NewMagneticFluxDensity(50, MagneticFluxDensity.Microtesla)
+		data, err := MarshalWithFormatASCII(field, FormatFull)
+		if err != nil {
+			t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+		}
+
+		var full MeasurementJSON
+		if err := json.Unmarshal(data, &full); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+		if full.Unit.Symbol != "uT" {
+			t.Errorf("Expected ASCII symbol uT, got %s", full.Unit.Symbol)
+		}
+
+		field2, err := UnmarshalMagneticFluxDensity(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal magnetic flux density: %v", err)
+		}
+		if !field.Equal(field2) || !field2.Unit.Equals(MagneticFluxDensity.Microtesla) {
+			t.Errorf("Round-trip serialization failed: got %v, expected %v", field2, field)
+		}
+	})
+
+	t.Run("AngularVelocity", func(t *testing.T) {
+		rate := NewAngularVelocity(30, AngularVelocity.DegreesPerSecond)
+		data, err := MarshalWithFormatASCII(rate, FormatFull)
+		if err != nil {
+			t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+		}
+
+		var full MeasurementJSON
+		if err := json.Unmarshal(data, &full); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+		if full.Unit.Symbol != "deg/s" {
+			t.Errorf("Expected ASCII symbol deg/s, got %s", full.Unit.Symbol)
+		}
+
+		rate2, err := UnmarshalAngularVelocity(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal angular velocity: %v", err)
+		}
+		if !rate.Equal(rate2) || !rate2.Unit.Equals(AngularVelocity.DegreesPerSecond) {
+			t.Errorf("Round-trip serialization failed: got %v, expected %v", rate2, rate)
+		}
+	})
+
+	t.Run("Unicode symbols still accepted", func(t *testing.T) {
+		data := []byte(`{"value":1,"unit":{"name":"Cubic Meter","symbol":"m³","dimension":"volume"}}`)
+		volume, err := UnmarshalVolume(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal volume: %v", err)
+		}
+		if !volume.Unit.Equals(Volume.CubicMeter) {
+			t.Errorf("Expected m³, got %s", volume.Unit.Symbol())
+		}
+	})
+}
+
+func TestDecodeMeasurements(t *testing.T) {
+	input := `[
+		{"value": 25.5, "unit": {"dimension": "temperature", "symbol": "°C", "name": "celsius"}},
+		{"value": 10, "unit": "length_meter"},
+		{"value": 2.5, "unit": {"key": "mass_kilogram", "symbol": "kg"}}
+	]`
+
+	measurements, err := DecodeMeasurements(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("DecodeMeasurements failed: %v", err)
+	}
+	if len(measurements) != 3 {
+		t.Fatalf("Expected 3 measurements, got %d", len(measurements))
+	}
+
+	temp, ok := measurements[0].AsTemperature()
+	if !ok || temp.Value != 25.5 || !temp.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("Expected 25.5 °C, got dimension %s", measurements[0].GetDimension())
+	}
+	length, ok := measurements[1].AsLength()
+	if !ok || length.Value != 10 || !length.Unit.Equals(Length.Meter) {
+		t.Errorf("Expected 10 m, got dimension %s", measurements[1].GetDimension())
+	}
+	mass, ok := measurements[2].AsMass()
+	if !ok || mass.Value != 2.5 || !mass.Unit.Equals(Mass.Kilogram) {
+		t.Errorf("Expected 2.5 kg, got dimension %s", measurements[2].GetDimension())
+	}
+
+	// Malformed element
+	_, err = DecodeMeasurements(strings.NewReader(`[{"value": 1, "unit": "length_meter"}, 42]`))
+	if err == nil {
+		t.Error("Expected error for malformed element")
+	}
+
+	// Not an array
+	_, err = DecodeMeasurements(strings.NewReader(`{"value": 1, "unit": "length_meter"}`))
+	if err == nil {
+		t.Error("Expected error for non-array input")
+	}
+}
+
+func TestFormatCompactWithDimension(t *testing.T) {
+	temp := NewTemperature(25.0, Temperature.Celsius)
+	data, err := MarshalWithFormat(temp, FormatCompactWithDimension)
+	if err != nil {
+		t.Fatalf("MarshalWithFormat failed: %v", err)
+	}
+	expected := `{"value":25,"unit":"temperature_celsius","dimension":"temperature","symbol":"°C"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	testCases := []struct {
+		name string
+		q    Quantity[Category]
+	}{
+		{"Temperature", Quantity[Category]{Value: -40, Unit: Temperature.Fahrenheit}},
+		{"Volume", Quantity[Category]{Value: 2.5, Unit: Volume.CubicMeter}},
+		{"Energy", Quantity[Category]{Value: 12, Unit: Energy.KilowattHour}},
+		{"ElectricCharge", Quantity[Category]{Value: 3.2, Unit: ElectricCharge.Coulomb}},
+		{"FuelEfficiency", Quantity[Category]{Value: 6.5, Unit: FuelEfficiency.LitersPer100Kilometers}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := MarshalWithFormat(tc.q, FormatCompactWithDimension)
+			if err != nil {
+				t.Fatalf("MarshalWithFormat failed: %v", err)
+			}
+
+			format, dimension, err := detectFormat(data)
+			if err != nil {
+				t.Fatalf("detectFormat failed: %v", err)
+			}
+			if format != FormatCompactWithDimension || dimension != tc.q.Unit.Dimension() {
+				t.Errorf("detectFormat = (%v, %q), expected (%v, %q)",
+					format, dimension, FormatCompactWithDimension, tc.q.Unit.Dimension())
+			}
+
+			anyM, err := UnmarshalMeasurement(data)
+			if err != nil {
+				t.Fatalf("UnmarshalMeasurement failed: %v", err)
+			}
+			if anyM.GetDimension() != tc.q.Unit.Dimension() {
+				t.Fatalf("Expected dimension %s, got %s", tc.q.Unit.Dimension(), anyM.GetDimension())
+			}
+			if got := fmt.Sprint(anyM.value); got != tc.q.String() {
+				t.Errorf("Round-trip serialization failed: got %s, expected %s", got, tc.q)
+			}
+		})
+	}
+
+	// ASCII symbols are accepted back
+	data, err = MarshalWithFormatASCII(NewVolume(1, Volume.CubicMeter), FormatCompactWithDimension)
+	if err != nil {
+		t.Fatalf("MarshalWithFormatASCII failed: %v", err)
+	}
+	volume, err := UnmarshalVolume(data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal volume: %v", err)
+	}
+	if !volume.Unit.Equals(Volume.CubicMeter) {
+		t.Errorf("Expected m³, got %s", volume.Unit.Symbol())
+	}
+}
+
+func TestMarshalPretty(t *testing.T) {
+	temp := NewTemperature(25.5, Temperature.Celsius)
+
+	data, err := MarshalPretty(temp, FormatFull)
+	if err != nil {
+		t.Fatalf("MarshalPretty failed: %v", err)
+	}
+	expected := `{
+  "value": 25.5,
+  "unit": {
+    "name": "Celsius",
+    "symbol": "°C",
+    "dimension": "temperature"
+  }
+}`
+	if string(data) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, data)
+	}
+
+	for _, format := range []SerializationFormat{FormatFull, FormatCompact, FormatMinimal, FormatCompactWithDimension} {
+		data, err := MarshalPretty(temp, format)
+		if err != nil {
+			t.Fatalf("MarshalPretty failed: %v", err)
+		}
+		if !strings.Contains(string(data), "\n  ") {
+			t.Errorf("Expected indented output for format %d, got %s", format, data)
+		}
+
+		anyM, err := UnmarshalMeasurement(data)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal %s: %v", data, err)
+		}
+		temp2, ok := anyM.AsTemperature()
+		if !ok || !temp2.Unit.Equals(Temperature.Celsius) || temp2.Value != 25.5 {
+			t.Errorf("Round-trip of format %d failed: got dimension %s", format, anyM.GetDimension())
+		}
+	}
+}
+
+func TestLegacyFormatsDeserialize(t *testing.T) {
+	for _, dim := range ListDimensions() {
+		for _, u := range registeredUnits(dim) {
+			legacyFull := fmt.Sprintf(`{"value":12.5,"dimension":%q,"unit":{"name":%q,"symbol":%q}}`,
+				dim, u.Name(), u.Symbol())
+			legacyCompact := fmt.Sprintf(`{"value":12.5,"unit":%q,"symbol":%q}`,
+				unitKey(dim, u.Name()), u.Symbol())
+
+			for _, payload := range []string{legacyFull, legacyCompact} {
+				m, err := UnmarshalMeasurement([]byte(payload))
+				if err != nil {
+					t.Errorf("UnmarshalMeasurement(%s): %v", payload, err)
+					continue
+				}
+				if m.GetDimension() != dim {
+					t.Errorf("UnmarshalMeasurement(%s) dimension = %q, expected %q", payload, m.GetDimension(), dim)
+					continue
+				}
+				q, ok := m.quantity()
+				if !ok {
+					t.Errorf("UnmarshalMeasurement(%s) returned no quantity", payload)
+					continue
+				}
+				if q.Value != 12.5 || !q.Unit.Equals(u) {
+					t.Errorf("UnmarshalMeasurement(%s) = %v %s, expected 12.5 %s", payload, q.Value, q.Unit.Symbol(), u.Symbol())
+				}
+			}
+		}
+	}
+}
+
+func TestCompoundDimensionsCoverRegistries(t *testing.T) {
+	for dim := range registries {
+		if !strings.Contains(dim, "_") {
+			continue
+		}
+		got, _ := splitUnitKey(dim + "_unit")
+		if got != dim {
+			t.Errorf("splitUnitKey(%q) dimension = %q, expected %q", dim+"_unit", got, dim)
+		}
+	}
+}
+
+func TestFlexibleValueUnmarshal(t *testing.T) {
+	accepted := []struct {
+		name    string
+		payload string
+	}{
+		{"number", `{"value":25,"unit":"temperature_celsius"}`},
+		{"quoted string", `{"value":"25","unit":"temperature_celsius"}`},
+		{"padded string", `{"value":" 25.0 ","unit":"temperature_celsius"}`},
+		{"amount object", `{"value":{"amount":25},"unit":"temperature_celsius"}`},
+		{"amount string", `{"value":{"amount":"25"},"unit":"temperature_celsius"}`},
+		{"full format", `{"value":"25","unit":{"name":"Celsius","symbol":"°C","dimension":"temperature"}}`},
+	}
+
+	for _, tc := range accepted {
+		t.Run(tc.name, func(t *testing.T) {
+			temp, err := UnmarshalTemperature([]byte(tc.payload))
+			if err != nil {
+				t.Fatalf("UnmarshalTemperature(%s): %v", tc.payload, err)
+			}
+			if temp.Value != 25 || !temp.Unit.Equals(Temperature.Celsius) {
+				t.Errorf("UnmarshalTemperature(%s) = %v, expected 25 °C", tc.payload, temp)
+			}
+		})
+	}
+
+	rejected := []string{
+		`{"value":"warm","unit":"temperature_celsius"}`,
+		`{"value":{"amount":"warm"},"unit":"temperature_celsius"}`,
+		`{"value":{"quantity":25},"unit":"temperature_celsius"}`,
+		`{"value":[25],"unit":"temperature_celsius"}`,
+	}
+	for _, payload := range rejected {
+		if _, err := unmarshalValue([]byte(payload)); err == nil {
+			t.Errorf("unmarshalValue(%s): expected error", payload)
+		}
+		if _, err := UnmarshalMeasurement([]byte(payload)); err == nil {
+			t.Errorf("UnmarshalMeasurement(%s): expected error", payload)
+		}
+	}
+}
+
+func TestValidatePayload(t *testing.T) {
+	valid := []string{
+		`{"value":25,"unit":{"name":"Celsius","symbol":"°C","dimension":"temperature"}}`,
+		`{"value":25,"unit":{"key":"temperature_celsius","symbol":"°C"}}`,
+		`{"value":25,"unit":"temperature_celsius"}`,
+		`{"value":25,"unit":"pressure_kilopascal","dimension":"pressure","symbol":"kPa"}`,
+		`{"value":25,"dimension":"length","unit":{"name":"Meter","symbol":"m"}}`,
+		`{"value":2,"unit":"electric_charge_milliampere-hour"}`,
+		`{"value":1,"unit":{"name":"Widget","symbol":"wdg","dimension":"general"}}`,
+	}
+	for _, payload := range valid {
+		if err := ValidatePayload([]byte(payload)); err != nil {
+			t.Errorf("ValidatePayload(%s): %v", payload, err)
+		}
+	}
+
+	invalid := []struct {
+		payload     string
+		expectedErr string
+	}{
+		{`{"value":25,"unit":{"name":"Pascal","symbol":"Pa","dimension":"temperature"}}`, `unit "Pa" belongs to dimension pressure, but "temperature" was declared`},
+		{`{"value":25,"unit":"temperature_celsius","dimension":"pressure","symbol":"°C"}`, `unit key "temperature_celsius" belongs to dimension "temperature", but "pressure" was declared`},
+		{`{"value":25,"unit":{"name":"Furlong","symbol":"fur","dimension":"length"}}`, `unknown length unit "fur"`},
+		{`{"value":25,"unit":"length_furlong"}`, `unknown length unit "furlong"`},
+		{`{"value":25,"unit":{"name":"Newton Meter","symbol":"N·m","dimension":"torque"}}`, `unknown dimension "torque"`},
+	}
+	for _, tc := range invalid {
+		err := ValidatePayload([]byte(tc.payload))
+		if err == nil || err.Error() != tc.expectedErr {
+			t.Errorf("ValidatePayload(%s) = %v, expected %q", tc.payload, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestUnmarshalMeasurementUsesDeclaredDimension(t *testing.T) {
+	testCases := []struct {
+		payload   string
+		dimension string
+		symbol    string
+	}{
+		// "C" is both the coulomb and an alias for degrees Celsius
+		{`{"value":1,"unit":{"name":"Coulomb","symbol":"C","dimension":"electric_charge"}}`, "electric_charge", "C"},
+		{`{"value":1,"unit":{"name":"Celsius","symbol":"C","dimension":"temperature"}}`, "temperature", "°C"},
+		// "g" is both the gram and the g-force
+		{`{"value":1,"unit":{"name":"Gram","symbol":"g","dimension":"mass"}}`, "mass", "g"},
+		{`{"value":1,"unit":{"name":"G-force","symbol":"g","dimension":"acceleration"}}`, "acceleration", "g"},
+		{`{"value":1,"unit":"acceleration_g-force"}`, "acceleration", "g"},
+		{`{"value":1,"unit":"mass_gram","dimension":"mass","symbol":"g"}`, "mass", "g"},
+		// "ppm" exists in both concentration and dispersion
+		{`{"value":1,"unit":{"key":"dispersion_parts_per_million","symbol":"ppm"}}`, "dispersion", "ppm"},
+	}
+
+	for _, tc := range testCases {
+		m, err := UnmarshalMeasurement([]byte(tc.payload))
+		if err != nil {
+			t.Errorf("UnmarshalMeasurement(%s): %v", tc.payload, err)
+			continue
+		}
+		q, _ := m.quantity()
+		if m.GetDimension() != tc.dimension || q.Unit.Symbol() != tc.symbol {
+			t.Errorf("UnmarshalMeasurement(%s) = %s %s, expected %s %s",
+				tc.payload, m.GetDimension(), q.Unit.Symbol(), tc.dimension, tc.symbol)
+		}
+	}
+
+	// An unsupported dimension is never rerouted by symbol
+	m, err := UnmarshalMeasurement([]byte(`{"value":5,"unit":"torque_newton_meter"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalMeasurement: %v", err)
+	}
+	if m.GetDimension() != "general" || m.OriginalDimension() != "torque" {
+		t.Errorf("torque payload = %s (original %q), expected general fallback from torque",
+			m.GetDimension(), m.OriginalDimension())
+	}
+}
+
+func TestWrap(t *testing.T) {
+	measurements := []*AnyMeasurement{
+		Wrap(NewTemperature(21.5, Temperature.Celsius)),
+		Wrap(NewLength(3, Length.Kilometer)),
+	}
+
+	if got := measurements[0].GetDimension(); got != "temperature" {
+		t.Errorf("GetDimension() = %q, expected temperature", got)
+	}
+	temp, ok := measurements[0].AsTemperature()
+	if !ok || temp.Value != 21.5 || !temp.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("AsTemperature() = %v, %v", temp, ok)
+	}
+	if _, ok := measurements[0].AsLength(); ok {
+		t.Error("AsLength() on a temperature should fail")
+	}
+
+	if got := measurements[1].GetDimension(); got != "length" {
+		t.Errorf("GetDimension() = %q, expected length", got)
+	}
+	length, ok := measurements[1].AsLength()
+	if !ok || length.Value != 3 || !length.Unit.Equals(Length.Kilometer) {
+		t.Errorf("AsLength() = %v, %v", length, ok)
+	}
+
+	general := Wrap(NewGeneral(5, NewGeneralUnit("widget", "wdg")))
+	if got := general.GetDimension(); got != "general" {
+		t.Errorf("GetDimension() = %q, expected general", got)
+	}
+}
+
+func TestBaseValue(t *testing.T) {
+	testCases := []struct {
+		m         *AnyMeasurement
+		value     float64
+		dimension string
+	}{
+		{Wrap(NewLength(1, Length.Kilometer)), 1000, "length"},
+		// The base unit of temperature is °C, not K
+		{Wrap(NewTemperature(25, Temperature.Celsius)), 25, "temperature"},
+		{Wrap(NewTemperature(298.15, Temperature.Kelvin)), 25, "temperature"},
+		{Wrap(NewMass(2.5, Mass.Gram)), 0.0025, "mass"},
+	}
+
+	for _, tc := range testCases {
+		value, dimension := tc.m.BaseValue()
+		if math.Abs(value-tc.value) > 1e-9 || dimension != tc.dimension {
+			t.Errorf("BaseValue() = (%v, %q), expected (%v, %q)", value, dimension, tc.value, tc.dimension)
+		}
+	}
+}
+
+func TestConvertBatch(t *testing.T) {
+	ms := []*AnyMeasurement{
+		Wrap(NewTemperature(100, Temperature.Celsius)),
+		Wrap(NewLength(1, Length.Meter)),
+		Wrap(NewMass(2, Mass.Kilogram)),
+		Wrap(NewTemperature(0, Temperature.Celsius)),
+	}
+
+	out, err := ConvertBatch(ms, map[string]string{"temperature": "°F", "length": "ft"})
+	if err != nil {
+		t.Fatalf("ConvertBatch error: %v", err)
+	}
+	if len(out) != len(ms) {
+		t.Fatalf("len = %d, expected %d", len(out), len(ms))
+	}
+
+	if temp, ok := out[0].AsTemperature(); !ok || math.Abs(temp.Value-212) > 1e-9 || !temp.Unit.Equals(Temperature.Fahrenheit) {
+		t.Errorf("out[0] = %v, expected 212 °F", temp)
+	}
+	if length, ok := out[1].AsLength(); !ok || math.Abs(length.Value-3.28084) > 1e-5 || !length.Unit.Equals(Length.Foot) {
+		t.Errorf("out[1] = %v, expected ≈3.28084 ft", length)
+	}
+	if out[2] != ms[2] {
+		t.Error("mass without a target should pass through unchanged")
+	}
+	if temp, ok := out[3].AsTemperature(); !ok || math.Abs(temp.Value-32) > 1e-9 {
+		t.Errorf("out[3] = %v, expected 32 °F", temp)
+	}
+	// The input is left as it was
+	if temp, _ := ms[0].AsTemperature(); temp.Value != 100 {
+		t.Errorf("input modified: %v", temp)
+	}
+
+	if _, err := ConvertBatch(ms, map[string]string{"length": "parsec"}); !errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("unknown target error = %v, expected ErrUnknownUnit", err)
+	}
+}
+
+func TestUnmarshalValueWithUnit(t *testing.T) {
+	for _, payload := range []string{`{"value":25}`, `{"value":"25","unit":"ignored"}`, `25`, ` "25" `} {
+		am, err := UnmarshalValueWithUnit([]byte(payload), "temperature", "°C")
+		if err != nil {
+			t.Fatalf("UnmarshalValueWithUnit(%s) error: %v", payload, err)
+		}
+		temp, ok := am.AsTemperature()
+		if !ok || temp.Value != 25 || !temp.Unit.Equals(Temperature.Celsius) || am.GetDimension() != "temperature" {
+			t.Errorf("UnmarshalValueWithUnit(%s) = %v, %v", payload, temp, ok)
+		}
+	}
+
+	am, err := UnmarshalValueWithUnit([]byte(`{"value":3.5}`), "length", "Kilometer")
+	if err != nil {
+		t.Fatalf("UnmarshalValueWithUnit by name error: %v", err)
+	}
+	if length, ok := am.AsLength(); !ok || length.Value != 3.5 || !length.Unit.Equals(Length.Kilometer) {
+		t.Errorf("UnmarshalValueWithUnit by name = %v, %v", length, ok)
+	}
+
+	errorCases := []struct {
+		payload, dimension, symbol string
+		kind                       error
+	}{
+		{`{"value":25}`, "temperature", "furlongs", ErrUnknownUnit},
+		{`{"value":25}`, "torque", "N·m", ErrUnknownUnit},
+		{`{"unit":"°C"}`, "temperature", "°C", ErrMalformedValue},
+		{`{"value":null}`, "temperature", "°C", ErrMalformedValue},
+		{`"warm"`, "temperature", "°C", ErrMalformedValue},
+	}
+	for _, tc := range errorCases {
+		if _, err := UnmarshalValueWithUnit([]byte(tc.payload), tc.dimension, tc.symbol); !errors.Is(err, tc.kind) {
+			t.Errorf("UnmarshalValueWithUnit(%s, %s, %s) error = %v, expected %v", tc.payload, tc.dimension, tc.symbol, err, tc.kind)
+		}
+	}
+}
+
+func TestFormatVersioned(t *testing.T) {
+	temp := NewTemperature(25.0, Temperature.Celsius)
+	data, err := MarshalWithFormat(temp, FormatVersioned)
+	if err != nil {
+		t.Fatalf("MarshalWithFormat failed: %v", err)
+	}
+	expected := `{"v":1,"value":25,"unit":"temperature_celsius","dimension":"temperature"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	for _, q := range []Quantity[Category]{
+		{Value: 25, Unit: Temperature.Celsius},
+		{Value: 2.5, Unit: ElectricCharge.Coulomb},
+		{Value: 6.5, Unit: FuelEfficiency.LitersPer100Kilometers},
+	} {
+		data, err := MarshalWithFormat(q, FormatVersioned)
+		if err != nil {
+			t.Fatalf("MarshalWithFormat failed: %v", err)
+		}
+		am, err := UnmarshalMeasurement(data)
+		if err != nil {
+			t.Fatalf("UnmarshalMeasurement(%s) failed: %v", data, err)
+		}
+		if am.GetDimension() != q.Unit.Dimension() || fmt.Sprint(am.value) != q.String() {
+			t.Errorf("Round trip of %s = %v (%s)", data, am.value, am.GetDimension())
+		}
+	}
+
+	// Payloads without "v" predate versioning and decode as before
+	am, err := UnmarshalMeasurement([]byte(`{"value":25,"unit":"temperature_celsius","dimension":"temperature","symbol":"°C"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalMeasurement of legacy payload failed: %v", err)
+	}
+	if got, ok := am.AsTemperature(); !ok || got.Value != 25 || !got.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("Legacy payload decoded to %v, %v", got, ok)
+	}
+
+	for _, payload := range []string{
+		`{"v":2,"value":25,"unit":"temperature_celsius","dimension":"temperature"}`,
+		`{"v":"1","value":25,"unit":"temperature_celsius","dimension":"temperature"}`,
+		`{"v":1,"value":25,"unit":"temperature_celsius"}`,
+	} {
+		if _, err := UnmarshalMeasurement([]byte(payload)); err == nil {
+			t.Errorf("Expected error for %s", payload)
+		}
+	}
+}
+
+func TestNormalizeMeasurements(t *testing.T) {
+	ms := []*AnyMeasurement{
+		Wrap(NewLength(1.5, Length.Meter)),
+		Wrap(NewLength(20, Length.Centimeter)),
+		Wrap(NewLength(0.002, Length.Kilometer)),
+	}
+	normalized, err := NormalizeMeasurements(ms)
+	if err != nil {
+		t.Fatalf("NormalizeMeasurements failed: %v", err)
+	}
+
+	expected := []float64{150, 20, 200}
+	for i, am := range normalized {
+		length, ok := am.AsLength()
+		if !ok || !length.Unit.Equals(Length.Centimeter) || math.Abs(length.Value-expected[i]) > 1e-9 {
+			t.Errorf("measurement %d = %v, expected %g cm", i, length, expected[i])
+		}
+	}
+	if original, _ := ms[0].AsLength(); !original.Unit.Equals(Length.Meter) {
+		t.Error("NormalizeMeasurements modified its input")
+	}
+
+	if out, err := NormalizeMeasurements(nil); err != nil || len(out) != 0 {
+		t.Errorf("NormalizeMeasurements(nil) = %v, %v", out, err)
+	}
+
+	mixed := []*AnyMeasurement{Wrap(NewLength(1, Length.Meter)), Wrap(NewMass(1, Mass.Kilogram))}
+	if _, err := NormalizeMeasurements(mixed); err == nil {
+		t.Error("Expected error for mixed dimensions")
+	}
+
+	// L/100km has no scale and must not win as the finest unit
+	testCases := []struct {
+		values   []Quantity[FuelEfficiencyUnit]
+		expected FuelEfficiencyUnit
+	}{
+		{[]Quantity[FuelEfficiencyUnit]{
+			NewFuelEfficiency(15, FuelEfficiency.KilometersPerLiter),
+			NewFuelEfficiency(6, FuelEfficiency.LitersPer100Kilometers),
+		}, FuelEfficiency.KilometersPerLiter},
+		{[]Quantity[FuelEfficiencyUnit]{
+			NewFuelEfficiency(0, FuelEfficiency.KilometersPerLiter),
+			NewFuelEfficiency(6, FuelEfficiency.LitersPer100Kilometers),
+		}, FuelEfficiency.KilometersPerLiter},
+		{[]Quantity[FuelEfficiencyUnit]{
+			NewFuelEfficiency(6, FuelEfficiency.LitersPer100Kilometers),
+			NewFuelEfficiency(8, FuelEfficiency.LitersPer100Kilometers),
+		}, FuelEfficiency.LitersPer100Kilometers},
+	}
+	for _, tc := range testCases {
+		ms := make([]*AnyMeasurement, len(tc.values))
+		for i, v := range tc.values {
+			ms[i] = Wrap(v)
+		}
+		normalized, err := NormalizeMeasurements(ms)
+		if err != nil {
+			t.Fatalf("NormalizeMeasurements(%v) failed: %v", tc.values, err)
+		}
+		for i, am := range normalized {
+			q, _ := am.AsFuelEfficiency()
+			if !q.Unit.Equals(tc.expected) || !q.Equal(tc.values[i]) {
+				t.Errorf("NormalizeMeasurements(%v)[%d] = %v, expected %v in %s", tc.values, i, q, tc.values[i], tc.expected.Symbol())
+			}
+		}
+	}
+}
+
+func TestExtrasRoundTrip(t *testing.T) {
+	payload := `{"value":21.5,"unit":"temperature_celsius","dimension":"temperature","symbol":"°C","sensorId":"kitchen-2","tags":["indoor"]}`
+	am, err := UnmarshalMeasurement([]byte(payload))
+	if err != nil {
+		t.Fatalf("UnmarshalMeasurement failed: %v", err)
+	}
+	if len(am.Extras) != 2 || string(am.Extras["sensorId"]) != `"kitchen-2"` {
+		t.Fatalf("Extras = %v, expected sensorId and tags", am.Extras)
+	}
+
+	data, err := json.Marshal(am)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Marshal produced invalid JSON %s: %v", data, err)
+	}
+	if string(fields["sensorId"]) != `"kitchen-2"` || string(fields["tags"]) != `["indoor"]` {
+		t.Errorf("Extras were not re-emitted: %s", data)
+	}
+
+	back, err := UnmarshalMeasurement(data)
+	if err != nil {
+		t.Fatalf("UnmarshalMeasurement(%s) failed: %v", data, err)
+	}
+	temp, ok := back.AsTemperature()
+	if !ok || temp.Value != 21.5 || !temp.Unit.Equals(Temperature.Celsius) || string(back.Extras["sensorId"]) != `"kitchen-2"` {
+		t.Errorf("Round trip = %v, %v, extras %v", temp, ok, back.Extras)
+	}
+
+	// Payloads without extra fields have no Extras, and Extras cannot
+	// override the measurement
+	am, err = UnmarshalMeasurement([]byte(`{"value":1,"unit":"length_meter"}`))
+	if err != nil || am.Extras != nil {
+		t.Fatalf("UnmarshalMeasurement = %v, %v, expected no extras", am.Extras, err)
+	}
+	am.Extras = map[string]json.RawMessage{"value": json.RawMessage(`99`)}
+	data, _ = json.Marshal(am)
+	if back, err := UnmarshalMeasurement(data); err != nil {
+		t.Errorf("UnmarshalMeasurement(%s) failed: %v", data, err)
+	} else if length, _ := back.AsLength(); length.Value != 1 {
+		t.Errorf("Extras overrode the value: %s", data)
+	}
+}
+
+func TestUnmarshalMeasurementMap(t *testing.T) {
+	payload := `{
+		"temperature": {"value": 21.5, "unit": "temperature_celsius"},
+		"pressure": {"value": 101.3, "unit": {"key": "pressure_kilopascal", "symbol": "kPa"}},
+		"supply": {"value": 3, "unit": {"name": "Meter", "symbol": "m", "dimension": "length"}}
+	}`
+	ms, err := UnmarshalMeasurementMap([]byte(payload))
+	if err != nil {
+		t.Fatalf("UnmarshalMeasurementMap failed: %v", err)
+	}
+	if len(ms) != 3 {
+		t.Fatalf("Expected 3 measurements, got %d", len(ms))
+	}
+
+	temp, ok := ms["temperature"].AsTemperature()
+	if !ok || temp.Value != 21.5 || !temp.Unit.Equals(Temperature.Celsius) {
+		t.Errorf("temperature = %v, %v", temp, ok)
+	}
+	pressure, ok := ms["pressure"].AsPressure()
+	if !ok || pressure.Value != 101.3 || !pressure.Unit.Equals(Pressure.Kilopascal) {
+		t.Errorf("pressure = %v, %v", pressure, ok)
+	}
+	if got := ms["supply"].GetDimension(); got != "length" {
+		t.Errorf("supply dimension = %q, expected length (keys need not name the dimension)", got)
+	}
+
+	for _, bad := range []string{`[]`, `{"temperature": 21.5}`, `{"temperature": {"value": 1}}`} {
+		if _, err := UnmarshalMeasurementMap([]byte(bad)); err == nil {
+			t.Errorf("Expected error for %s", bad)
+		}
+	}
+}
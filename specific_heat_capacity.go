@@ -0,0 +1,41 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+// SpecificHeatCapacityUnit represents a unit of specific heat capacity, the
+// heat capacity per unit mass of a material
+type SpecificHeatCapacityUnit struct {
+	BaseUnit
+}
+
+// SpecificHeatCapacity contains predefined specific heat capacity units
+var SpecificHeatCapacity = struct {
+	JoulesPerKilogramKelvin        SpecificHeatCapacityUnit
+	KilocaloriesPerKilogramCelsius SpecificHeatCapacityUnit
+}{
+	JoulesPerKilogramKelvin: SpecificHeatCapacityUnit{
+		BaseUnit: NewBaseUnit(
+			"specific_heat_capacity",
+			"J/(kg·K)",
+			"Joules per Kilogram Kelvin",
+			1.0,
+			0.0,
+			true, // Base unit
+		),
+	},
+	KilocaloriesPerKilogramCelsius: SpecificHeatCapacityUnit{
+		BaseUnit: NewBaseUnit(
+			"specific_heat_capacity",
+			"kcal/(kg·°C)",
+			"Kilocalories per Kilogram Degree Celsius",
+			4184.0, // Thermochemical kilocalorie: 1 kcal/(kg·°C) = 4184 J/(kg·K)
+			0.0,
+			false,
+		),
+	},
+}
+
+// NewSpecificHeatCapacity creates a new specific heat capacity quantity
+func NewSpecificHeatCapacity(value float64, unit SpecificHeatCapacityUnit) Quantity[SpecificHeatCapacityUnit] {
+	return New(value, unit)
+}
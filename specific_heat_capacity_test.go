@@ -0,0 +1,53 @@
+package unit
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSpecificHeatCapacityConversion(t *testing.T) {
+	// Water: about 4186 J/(kg·K), historically defined as 1 kcal/(kg·°C)
+	water := NewSpecificHeatCapacity(4186, SpecificHeatCapacity.JoulesPerKilogramKelvin)
+	kcal := water.ConvertTo(SpecificHeatCapacity.KilocaloriesPerKilogramCelsius)
+	if math.Abs(kcal.Value-1) > 0.001 {
+		t.Errorf("4186 J/(kg·K) = %g kcal/(kg·°C), expected ≈ 1", kcal.Value)
+	}
+
+	joules := NewSpecificHeatCapacity(1, SpecificHeatCapacity.KilocaloriesPerKilogramCelsius).
+		ConvertTo(SpecificHeatCapacity.JoulesPerKilogramKelvin)
+	if joules.Value != 4184 {
+		t.Errorf("1 kcal/(kg·°C) = %g J/(kg·K), expected 4184", joules.Value)
+	}
+}
+
+func TestSpecificHeatCapacitySymbols(t *testing.T) {
+	// Both symbols carry non-ASCII characters and a parenthesized denominator
+	testCases := []struct {
+		unit     SpecificHeatCapacityUnit
+		expected string
+	}{
+		{SpecificHeatCapacity.JoulesPerKilogramKelvin, `"J/(kg*K)"`},
+		{SpecificHeatCapacity.KilocaloriesPerKilogramCelsius, `"kcal/(kg*degC)"`},
+	}
+
+	for _, tc := range testCases {
+		data, err := MarshalWithFormatASCII(NewSpecificHeatCapacity(1, tc.unit), FormatFull)
+		if err != nil || !strings.Contains(string(data), tc.expected) {
+			t.Errorf("MarshalWithFormatASCII(%s) = %s, %v", tc.unit.Symbol(), data, err)
+			continue
+		}
+		am, err := UnmarshalMeasurement(data)
+		if err != nil {
+			t.Fatalf("UnmarshalMeasurement(%s) error: %v", data, err)
+		}
+		if got, ok := am.AsSpecificHeatCapacity(); !ok || got.Value != 1 || !got.Unit.Equals(tc.unit) {
+			t.Errorf("round trip of %s = %v, %v", data, got, ok)
+		}
+	}
+
+	q, err := ParseQuantity[SpecificHeatCapacityUnit]("4186 J/(kg·K)")
+	if err != nil || q.Value != 4186 || !q.Unit.Equals(SpecificHeatCapacity.JoulesPerKilogramKelvin) {
+		t.Errorf("ParseQuantity[SpecificHeatCapacityUnit] = %v, %v", q, err)
+	}
+}
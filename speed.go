@@ -2,6 +2,8 @@
 // physical quantities with units.
 package unit
 
+import "fmt"
+
 // SpeedUnit represents a unit of speed
 type SpeedUnit struct {
 	BaseUnit
@@ -14,6 +16,7 @@ var Speed = struct {
 	MilesPerHour      SpeedUnit
 	FeetPerSecond     SpeedUnit
 	Knot              SpeedUnit
+	FeetPerMinute     SpeedUnit
 }{
 	MetersPerSecond: SpeedUnit{
 		BaseUnit: NewBaseUnit(
@@ -65,9 +68,38 @@ var Speed = struct {
 			false,
 		),
 	},
+	FeetPerMinute: SpeedUnit{
+		BaseUnit: NewBaseUnit(
+			"speed",
+			"fpm",
+			"Feet per Minute",
+			0.00508, // 1 fpm = 0.3048 m / 60 s = 0.00508 m/s
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewSpeed creates a new speed quantity
 func NewSpeed(value float64, unit SpeedUnit) Quantity[SpeedUnit] {
 	return New(value, unit)
 }
+
+// NewSpeedFrom returns the average speed covering distance in duration, in
+// m/s, e.g. 100 m over 10 s is 10 m/s. It returns an error if duration is zero.
+func NewSpeedFrom(distance Quantity[LengthUnit], duration Quantity[DurationUnit]) (Quantity[SpeedUnit], error) {
+	if duration.Unit.ConvertToBaseUnit(duration.Value) == 0 {
+		return Quantity[SpeedUnit]{}, fmt.Errorf("cannot compute speed over zero duration")
+	}
+	return NewSpeed(distance.PerUnit(duration), Speed.MetersPerSecond), nil
+}
+
+// KmhString formats m in km/h with the given number of decimals, e.g. "36 km/h"
+func KmhString(m Quantity[SpeedUnit], decimals int) string {
+	return m.ConvertAndFormat(Speed.KilometersPerHour, decimals)
+}
+
+// MphString formats m in mph with the given number of decimals, e.g. "22 mph"
+func MphString(m Quantity[SpeedUnit], decimals int) string {
+	return m.ConvertAndFormat(Speed.MilesPerHour, decimals)
+}
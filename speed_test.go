@@ -0,0 +1,121 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFeetPerMinute(t *testing.T) {
+	// Typical climb rate: 1000 fpm ≈ 5.08 m/s
+	mps := NewSpeed(1000, Speed.FeetPerMinute).ConvertTo(Speed.MetersPerSecond)
+	if math.Abs(mps.Value-5.08) > 1e-9 {
+		t.Errorf("1000 fpm = %g m/s, expected 5.08 m/s", mps.Value)
+	}
+
+	// 60 fpm = 1 ft/s
+	fps := NewSpeed(60, Speed.FeetPerMinute).ConvertTo(Speed.FeetPerSecond)
+	if math.Abs(fps.Value-1) > 1e-9 {
+		t.Errorf("60 fpm = %g ft/s, expected 1 ft/s", fps.Value)
+	}
+}
+
+func TestFeetPerMinuteParsing(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected SpeedUnit
+	}{
+		{"500 fpm", Speed.FeetPerMinute},
+		{"500 ft/min", Speed.FeetPerMinute},
+		{"500 feet per minute", Speed.FeetPerMinute},
+		{"500 mph", Speed.MilesPerHour},
+		{"500 m/s", Speed.MetersPerSecond},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			speed, err := ParseSpeed(tc.input)
+			if err != nil {
+				t.Fatalf("Failed to parse %q: %v", tc.input, err)
+			}
+			if speed.Value != 500 || !speed.Unit.Equals(tc.expected) {
+				t.Errorf("ParseSpeed(%q) = %v, expected 500 %s", tc.input, speed, tc.expected.Symbol())
+			}
+		})
+	}
+}
+
+func TestFeetPerMinuteSerialization(t *testing.T) {
+	climb := NewSpeed(1500, Speed.FeetPerMinute)
+
+	data, err := MarshalCompactSpeed(climb)
+	if err != nil {
+		t.Fatalf("Failed to marshal compact speed: %v", err)
+	}
+	if got := string(data); got != `{"value":1500,"unit":"speed_feet_per_minute"}` {
+		t.Errorf("Unexpected compact JSON: %s", got)
+	}
+
+	back, err := UnmarshalCompactSpeed(data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal compact speed: %v", err)
+	}
+	if !back.Equal(climb) || !back.Unit.Equals(Speed.FeetPerMinute) {
+		t.Errorf("Round-trip failed: got %v, expected %v", back, climb)
+	}
+
+	m, err := UnmarshalMeasurement(data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal speed measurement: %v", err)
+	}
+	if speed, ok := m.AsSpeed(); !ok || !speed.Unit.Equals(Speed.FeetPerMinute) {
+		t.Errorf("Expected fpm speed, got %v", speed)
+	}
+}
+
+func TestKmhAndMphString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"10 m/s in km/h", KmhString(NewSpeed(10, Speed.MetersPerSecond), 0), "36 km/h"},
+		{"27.78 m/s in km/h", KmhString(NewSpeed(27.78, Speed.MetersPerSecond), 0), "100 km/h"},
+		{"27.78 m/s in km/h with decimals", KmhString(NewSpeed(27.78, Speed.MetersPerSecond), 2), "100.01 km/h"},
+		{"10 m/s in mph", MphString(NewSpeed(10, Speed.MetersPerSecond), 1), "22.4 mph"},
+		{"100 km/h in mph", MphString(NewSpeed(100, Speed.KilometersPerHour), 0), "62 mph"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("got %q, expected %q", tc.got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewSpeedFrom(t *testing.T) {
+	speed, err := NewSpeedFrom(NewLength(100, Length.Meter), NewDuration(10, Duration.Second))
+	if err != nil {
+		t.Fatalf("NewSpeedFrom failed: %v", err)
+	}
+	if speed.Value != 10 || !speed.Unit.Equals(Speed.MetersPerSecond) {
+		t.Errorf("100 m over 10 s = %v, expected 10 m/s", speed)
+	}
+	if kmh := speed.ConvertTo(Speed.KilometersPerHour); math.Abs(kmh.Value-36) > 1e-9 {
+		t.Errorf("10 m/s = %g km/h, expected 36 km/h", kmh.Value)
+	}
+
+	// Units of the inputs are converted to base first: 5 km in 30 min is 10 km/h
+	speed, err = NewSpeedFrom(NewLength(5, Length.Kilometer), NewDuration(30, Duration.Minute))
+	if err != nil {
+		t.Fatalf("NewSpeedFrom failed: %v", err)
+	}
+	if kmh := speed.ConvertTo(Speed.KilometersPerHour); math.Abs(kmh.Value-10) > 1e-9 {
+		t.Errorf("5 km over 30 min = %g km/h, expected 10 km/h", kmh.Value)
+	}
+
+	if _, err := NewSpeedFrom(NewLength(100, Length.Meter), NewDuration(0, Duration.Hour)); err == nil {
+		t.Error("Expected error for zero duration")
+	}
+}
@@ -28,8 +28,8 @@ var Temperature = struct {
 			"temperature",
 			"°F",
 			"Fahrenheit",
-			5.0/9.0, // Conversion factor: (F - 32) * 5/9 = C
-			-32*5/9, // Offset adjustment
+			5.0/9.0,       // Conversion factor: (F - 32) * 5/9 = C
+			-32.0*5.0/9.0, // Offset adjustment (float constants; -32*5/9 would truncate to -17)
 			false,
 		),
 	},
@@ -45,7 +45,23 @@ var Temperature = struct {
 	},
 }
 
+// IsAffine returns true for temperature scales whose zero is not absolute zero,
+// i.e. every scale except Kelvin. The base unit °C is itself affine.
+func (u TemperatureUnit) IsAffine() bool {
+	return u.ConvertToBaseUnit(0) != Temperature.Kelvin.ConvertToBaseUnit(0)
+}
+
 // NewTemperature creates a new temperature quantity
 func NewTemperature(value float64, unit TemperatureUnit) Quantity[TemperatureUnit] {
 	return New(value, unit)
 }
+
+// ClampToPhysical returns m raised to absolute zero, in m's unit, if it is
+// below absolute zero, and m unchanged otherwise; -300 °C becomes -273.15 °C.
+// Clamping is opt-in: conversions and arithmetic never clamp on their own.
+func ClampToPhysical(m Quantity[TemperatureUnit]) Quantity[TemperatureUnit] {
+	if m.ConvertTo(Temperature.Kelvin).Value < 0 {
+		return Constants.AbsoluteZero.ConvertTo(m.Unit)
+	}
+	return m
+}
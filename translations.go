@@ -0,0 +1,79 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// translationKey identifies a unit by dimension and symbol, consistent with BaseUnit.Equals
+type translationKey struct {
+	dimension string
+	symbol    string
+}
+
+// unitTranslations maps a unit to its names by lower-cased language tag
+var unitTranslations = struct {
+	sync.RWMutex
+	names map[translationKey]map[string]string
+}{names: map[translationKey]map[string]string{
+	{"length", "m"}:       {"de": "Meter", "fr": "mètre", "cs": "metr"},
+	{"length", "km"}:      {"de": "Kilometer", "fr": "kilomètre", "cs": "kilometr"},
+	{"mass", "kg"}:        {"de": "Kilogramm", "fr": "kilogramme", "cs": "kilogram"},
+	{"temperature", "°C"}: {"de": "Grad Celsius", "fr": "degré Celsius", "cs": "stupeň Celsia"},
+	{"volume", "L"}:       {"de": "Liter", "fr": "litre", "cs": "litr"},
+}}
+
+// RegisterUnitTranslation registers the name of the unit with symbol in
+// dimension for language lang (e.g. "de"), used by StringLocalized. It panics
+// if the dimension or unit is not registered.
+func RegisterUnitTranslation(dimension, symbol, lang, name string) {
+	r, ok := registries[dimension]
+	if !ok {
+		panic(fmt.Sprintf("unit: cannot register translation for unknown dimension %q", dimension))
+	}
+	u, ok := r.resolve(symbol)
+	if !ok {
+		panic(fmt.Sprintf("unit: cannot register translation for unknown %s unit %q", dimension, symbol))
+	}
+
+	key := translationKey{dimension: dimension, symbol: u.Symbol()}
+	unitTranslations.Lock()
+	defer unitTranslations.Unlock()
+	names, ok := unitTranslations.names[key]
+	if !ok {
+		names = make(map[string]string)
+		unitTranslations.names[key] = names
+	}
+	names[strings.ToLower(lang)] = name
+}
+
+// translatedName returns the name of unit in lang, trying the full language
+// tag ("de-at") before its primary subtag ("de")
+func translatedName(unit Category, lang string) (string, bool) {
+	lang = strings.ToLower(lang)
+	unitTranslations.RLock()
+	defer unitTranslations.RUnlock()
+	names := unitTranslations.names[translationKey{dimension: unit.Dimension(), symbol: unit.Symbol()}]
+	if name, ok := names[lang]; ok {
+		return name, true
+	}
+	if primary, _, found := strings.Cut(lang, "-"); found {
+		name, ok := names[primary]
+		return name, ok
+	}
+	return "", false
+}
+
+// StringLocalized returns the value followed by the unit name in language
+// lang, e.g. "5 Meter" for "de", falling back to the English unit name
+func (m Quantity[T]) StringLocalized(lang string) string {
+	name, ok := translatedName(m.Unit, lang)
+	if !ok {
+		name = m.Unit.Name()
+	}
+	return strconv.FormatFloat(m.Value, 'g', -1, 64) + " " + name
+}
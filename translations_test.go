@@ -0,0 +1,45 @@
+package unit
+
+import "testing"
+
+func TestStringLocalized(t *testing.T) {
+	RegisterUnitTranslation("length", "m", "de", "Meter")
+	RegisterUnitTranslation("temperature", "°C", "de", "Grad Celsius")
+
+	testCases := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"German length", NewLength(5, Length.Meter).StringLocalized("de"), "5 Meter"},
+		{"German temperature", NewTemperature(21.5, Temperature.Celsius).StringLocalized("de"), "21.5 Grad Celsius"},
+		{"regional tag", NewTemperature(21.5, Temperature.Celsius).StringLocalized("de-AT"), "21.5 Grad Celsius"},
+		{"case-insensitive tag", NewLength(5, Length.Meter).StringLocalized("DE"), "5 Meter"},
+		{"seeded French", NewLength(2, Length.Kilometer).StringLocalized("fr"), "2 kilomètre"},
+		{"untranslated unit", NewLength(3, Length.Foot).StringLocalized("de"), "3 Foot"},
+		{"unknown language", NewLength(5, Length.Meter).StringLocalized("xx"), "5 Meter"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("StringLocalized = %q, expected %q", tc.got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterUnitTranslation(t *testing.T) {
+	// ASCII symbols resolve to the canonical unit
+	RegisterUnitTranslation("temperature", "degF", "de", "Grad Fahrenheit")
+	if got := NewTemperature(70, Temperature.Fahrenheit).StringLocalized("de"); got != "70 Grad Fahrenheit" {
+		t.Errorf("StringLocalized = %q, expected %q", got, "70 Grad Fahrenheit")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unknown unit")
+		}
+	}()
+	RegisterUnitTranslation("length", "parsec", "de", "Parsec")
+}
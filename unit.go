@@ -44,6 +44,12 @@ func (u BaseUnit) IsBaseUnit() bool {
 	return u.isBase
 }
 
+// IsAffine returns true if the unit converts to the base unit with an offset.
+// Units of dimensions whose base unit is itself affine override this.
+func (u BaseUnit) IsAffine() bool {
+	return !u.isBase && u.offset != 0
+}
+
 // ConvertToBaseUnit converts a value in this unit to the base unit
 func (u BaseUnit) ConvertToBaseUnit(value float64) float64 {
 	if u.isBase {
@@ -74,6 +80,16 @@ func (u BaseUnit) ConvertFromBaseUnit(value float64) float64 {
 	return value / u.coefficient
 }
 
+// linearFactors returns the scale and offset that map a value in this unit to
+// the base unit (base = value*scale + offset). ok is false for units whose
+// conversion is not linear.
+func (u BaseUnit) linearFactors() (scale, offset float64, ok bool) {
+	if u.isBase {
+		return 1.0, 0.0, true
+	}
+	return u.coefficient, u.offset, true
+}
+
 // Equals checks if this unit is equal to another unit
 func (u BaseUnit) Equals(other Category) bool {
 	// Check if dimensions match
@@ -2,6 +2,14 @@
 // physical quantities with units.
 package unit
 
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // UnitRegistry provides lookup functionality for units by symbol
 // Each unit type has its own registry map
 
@@ -20,6 +28,7 @@ var pressureUnitsBySymbol = map[string]PressureUnit{
 	"psi":   Pressure.PSI,
 	"inH₂O": Pressure.InchH2O,
 	"inH2O": Pressure.InchH2O,
+	"inHg":  Pressure.InchHg,
 }
 
 var flowRateUnitsBySymbol = map[string]FlowRateUnit{
@@ -32,12 +41,14 @@ var powerUnitsBySymbol = map[string]PowerUnit{
 	"W":     Power.Watt,
 	"kW":    Power.Kilowatt,
 	"BTU/h": Power.BTUPerHour,
+	"RT":    Power.TonRefrigeration,
 }
 
 var energyUnitsBySymbol = map[string]EnergyUnit{
-	"J":   Energy.Joule,
-	"kWh": Energy.KilowattHour,
-	"BTU": Energy.BTU,
+	"J":    Energy.Joule,
+	"kWh":  Energy.KilowattHour,
+	"BTU":  Energy.BTU,
+	"tTNT": Energy.TonTNT,
 }
 
 var lengthUnitsBySymbol = map[string]LengthUnit{
@@ -54,15 +65,16 @@ var lengthUnitsBySymbol = map[string]LengthUnit{
 }
 
 var massUnitsBySymbol = map[string]MassUnit{
-	"kg":  Mass.Kilogram,
-	"g":   Mass.Gram,
-	"mg":  Mass.Milligram,
-	"µg":  Mass.Microgram,
-	"lb":  Mass.Pound,
-	"oz":  Mass.Ounce,
-	"st":  Mass.Stone,
-	"t":   Mass.MetricTon,
-	"ton": Mass.Ton,
+	"kg":       Mass.Kilogram,
+	"g":        Mass.Gram,
+	"mg":       Mass.Milligram,
+	"µg":       Mass.Microgram,
+	"lb":       Mass.Pound,
+	"oz":       Mass.Ounce,
+	"st":       Mass.Stone,
+	"t":        Mass.MetricTon,
+	"ton":      Mass.Ton,
+	"long ton": Mass.LongTon,
 }
 
 var durationUnitsBySymbol = map[string]DurationUnit{
@@ -98,20 +110,23 @@ var areaUnitsBySymbol = map[string]AreaUnit{
 }
 
 var volumeUnitsBySymbol = map[string]VolumeUnit{
-	"m³":    Volume.CubicMeter,
-	"km³":   Volume.CubicKilometer,
-	"cm³":   Volume.CubicCentimeter,
-	"mm³":   Volume.CubicMillimeter,
-	"L":     Volume.Liter,
-	"mL":    Volume.Milliliter,
-	"in³":   Volume.CubicInch,
-	"ft³":   Volume.CubicFoot,
-	"yd³":   Volume.CubicYard,
-	"gal":   Volume.Gallon,
-	"qt":    Volume.Quart,
-	"pt":    Volume.Pint,
-	"cup":   Volume.Cup,
-	"fl oz": Volume.FluidOunce,
+	"m³":        Volume.CubicMeter,
+	"km³":       Volume.CubicKilometer,
+	"cm³":       Volume.CubicCentimeter,
+	"mm³":       Volume.CubicMillimeter,
+	"L":         Volume.Liter,
+	"mL":        Volume.Milliliter,
+	"in³":       Volume.CubicInch,
+	"ft³":       Volume.CubicFoot,
+	"yd³":       Volume.CubicYard,
+	"gal":       Volume.Gallon,
+	"qt":        Volume.Quart,
+	"pt":        Volume.Pint,
+	"cup":       Volume.Cup,
+	"fl oz":     Volume.FluidOunce,
+	"imp pt":    Volume.ImperialPint,
+	"imp fl oz": Volume.ImperialFluidOunce,
+	"bf":        Volume.BoardFoot,
 }
 
 var accelerationUnitsBySymbol = map[string]AccelerationUnit{
@@ -125,6 +140,7 @@ var concentrationUnitsBySymbol = map[string]ConcentrationUnit{
 	"mg/L": Concentration.MilligramsPerLiter,
 	"ppm":  Concentration.PartsPerMillion,
 	"ppb":  Concentration.PartsPerBillion,
+	"gpg":  Concentration.GrainsPerGallon,
 }
 
 var dispersionUnitsBySymbol = map[string]DispersionUnit{
@@ -140,6 +156,7 @@ var speedUnitsBySymbol = map[string]SpeedUnit{
 	"mph":  Speed.MilesPerHour,
 	"ft/s": Speed.FeetPerSecond,
 	"kn":   Speed.Knot,
+	"fpm":  Speed.FeetPerMinute,
 }
 
 var electricChargeUnitsBySymbol = map[string]ElectricChargeUnit{
@@ -182,18 +199,21 @@ var illuminanceUnitsBySymbol = map[string]IlluminanceUnit{
 }
 
 var informationUnitsBySymbol = map[string]InformationUnit{
-	"bit": Information.Bit,
-	"B":   Information.Byte,
-	"KB":  Information.Kilobyte,
-	"MB":  Information.Megabyte,
-	"GB":  Information.Gigabyte,
-	"TB":  Information.Terabyte,
-	"PB":  Information.Petabyte,
-	"KiB": Information.Kibibyte,
-	"MiB": Information.Mebibyte,
-	"GiB": Information.Gibibyte,
-	"TiB": Information.Tebibyte,
-	"PiB": Information.Pebibyte,
+	"bit":   Information.Bit,
+	"B":     Information.Byte,
+	"KB":    Information.Kilobyte,
+	"MB":    Information.Megabyte,
+	"GB":    Information.Gigabyte,
+	"TB":    Information.Terabyte,
+	"PB":    Information.Petabyte,
+	"KiB":   Information.Kibibyte,
+	"MiB":   Information.Mebibyte,
+	"GiB":   Information.Gibibyte,
+	"TiB":   Information.Tebibyte,
+	"PiB":   Information.Pebibyte,
+	"Kibit": Information.Kibibit,
+	"Mibit": Information.Mebibit,
+	"Gibit": Information.Gibibit,
 }
 
 var fuelEfficiencyUnitsBySymbol = map[string]FuelEfficiencyUnit{
@@ -202,6 +222,58 @@ var fuelEfficiencyUnitsBySymbol = map[string]FuelEfficiencyUnit{
 	"L/100km": FuelEfficiency.LitersPer100Kilometers,
 }
 
+var ratioUnitsBySymbol = map[string]RatioUnit{
+	":1": Ratio.Dimensionless,
+}
+
+var densityUnitsBySymbol = map[string]DensityUnit{
+	"kg/m³":  Density.KilogramsPerCubicMeter,
+	"g/cm³":  Density.GramsPerCubicCentimeter,
+	"lb/ft³": Density.PoundsPerCubicFoot,
+}
+
+var electricFieldUnitsBySymbol = map[string]ElectricFieldUnit{
+	"V/m":  ElectricField.VoltsPerMeter,
+	"kV/m": ElectricField.KilovoltsPerMeter,
+	"V/cm": ElectricField.VoltsPerCentimeter,
+}
+
+var magneticFluxDensityUnitsBySymbol = map[string]MagneticFluxDensityUnit{
+	"T":  MagneticFluxDensity.Tesla,
+	"mT": MagneticFluxDensity.Millitesla,
+	"µT": MagneticFluxDensity.Microtesla,
+	"G":  MagneticFluxDensity.Gauss,
+}
+
+var angularVelocityUnitsBySymbol = map[string]AngularVelocityUnit{
+	"rad/s": AngularVelocity.RadiansPerSecond,
+	"°/s":   AngularVelocity.DegreesPerSecond,
+}
+
+var areaDensityUnitsBySymbol = map[string]AreaDensityUnit{
+	"g/m²":   AreaDensity.GramsPerSquareMeter,
+	"kg/m²":  AreaDensity.KilogramsPerSquareMeter,
+	"oz/yd²": AreaDensity.OuncesPerSquareYard,
+}
+
+var linearDensityUnitsBySymbol = map[string]LinearDensityUnit{
+	"kg/m": LinearDensity.KilogramsPerMeter,
+	"g/m":  LinearDensity.GramsPerMeter,
+	"tex":  LinearDensity.Tex,
+	"den":  LinearDensity.Denier,
+}
+
+var heatCapacityUnitsBySymbol = map[string]HeatCapacityUnit{
+	"J/K":   HeatCapacity.JoulesPerKelvin,
+	"kJ/K":  HeatCapacity.KilojoulesPerKelvin,
+	"cal/K": HeatCapacity.CaloriesPerKelvin,
+}
+
+var specificHeatCapacityUnitsBySymbol = map[string]SpecificHeatCapacityUnit{
+	"J/(kg·K)":     SpecificHeatCapacity.JoulesPerKilogramKelvin,
+	"kcal/(kg·°C)": SpecificHeatCapacity.KilocaloriesPerKilogramCelsius,
+}
+
 // LookupTemperatureUnit returns the temperature unit for the given symbol
 func LookupTemperatureUnit(symbol string) (TemperatureUnit, bool) {
 	u, ok := temperatureUnitsBySymbol[symbol]
@@ -333,3 +405,449 @@ func LookupFuelEfficiencyUnit(symbol string) (FuelEfficiencyUnit, bool) {
 	u, ok := fuelEfficiencyUnitsBySymbol[symbol]
 	return u, ok
 }
+
+// LookupRatioUnit returns the ratio unit for the given symbol
+func LookupRatioUnit(symbol string) (RatioUnit, bool) {
+	u, ok := ratioUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupDensityUnit returns the density unit for the given symbol
+func LookupDensityUnit(symbol string) (DensityUnit, bool) {
+	u, ok := densityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupElectricFieldUnit returns the electric field unit for the given symbol
+func LookupElectricFieldUnit(symbol string) (ElectricFieldUnit, bool) {
+	u, ok := electricFieldUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupMagneticFluxDensityUnit returns the magnetic flux density unit for the given symbol
+func LookupMagneticFluxDensityUnit(symbol string) (MagneticFluxDensityUnit, bool) {
+	u, ok := magneticFluxDensityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupAngularVelocityUnit returns the angular velocity unit for the given symbol
+func LookupAngularVelocityUnit(symbol string) (AngularVelocityUnit, bool) {
+	u, ok := angularVelocityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupAreaDensityUnit returns the area density unit for the given symbol
+func LookupAreaDensityUnit(symbol string) (AreaDensityUnit, bool) {
+	u, ok := areaDensityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupLinearDensityUnit returns the linear density unit for the given symbol
+func LookupLinearDensityUnit(symbol string) (LinearDensityUnit, bool) {
+	u, ok := linearDensityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupHeatCapacityUnit returns the heat capacity unit for the given symbol
+func LookupHeatCapacityUnit(symbol string) (HeatCapacityUnit, bool) {
+	u, ok := heatCapacityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// LookupSpecificHeatCapacityUnit returns the specific heat capacity unit for the given symbol
+func LookupSpecificHeatCapacityUnit(symbol string) (SpecificHeatCapacityUnit, bool) {
+	u, ok := specificHeatCapacityUnitsBySymbol[symbol]
+	return u, ok
+}
+
+// UnitInfo describes a registered unit, including the linear transform to its
+// dimension's base unit (base = value*Scale + Offset) so that clients can
+// replicate conversions without calling back into the package
+type UnitInfo struct {
+	Dimension string  `json:"dimension"`
+	Symbol    string  `json:"symbol"`
+	Name      string  `json:"name"`
+	IsBase    bool    `json:"isBase"`
+	Scale     float64 `json:"scale"`
+	Offset    float64 `json:"offset"`
+	Linear    bool    `json:"linear"` // false for reciprocal units such as L/100km
+}
+
+// dimensions lists every dimension with a symbol registry
+var dimensions = []string{
+	"acceleration",
+	"angle",
+	"angular_velocity",
+	"area",
+	"area_density",
+	"concentration",
+	"density",
+	"dispersion",
+	"duration",
+	"electric_charge",
+	"electric_current",
+	"electric_field",
+	"electric_potential_difference",
+	"energy",
+	"flowrate",
+	"frequency",
+	"fuel_efficiency",
+	"heat_capacity",
+	"illuminance",
+	"information",
+	"length",
+	"linear_density",
+	"magnetic_flux_density",
+	"mass",
+	"power",
+	"pressure",
+	"ratio",
+	"specific_heat_capacity",
+	"speed",
+	"temperature",
+	"volume",
+}
+
+// ListDimensions returns the names of all registered dimensions in sorted order
+func ListDimensions() []string {
+	out := make([]string, len(dimensions))
+	copy(out, dimensions)
+	return out
+}
+
+// ListUnits returns information about every unit registered for the given
+// dimension, ordered from the smallest to the largest base-unit scale.
+// It returns nil for unknown dimensions.
+func ListUnits(dimension string) []UnitInfo {
+	units := registeredUnits(dimension)
+	if units == nil {
+		return nil
+	}
+
+	infos := make([]UnitInfo, 0, len(units))
+	for _, u := range units {
+		scale, offset, linear := unitFactors(u)
+		infos = append(infos, UnitInfo{
+			Dimension: u.Dimension(),
+			Symbol:    u.Symbol(),
+			Name:      u.Name(),
+			IsBase:    u.IsBaseUnit(),
+			Scale:     scale,
+			Offset:    offset,
+			Linear:    linear,
+		})
+	}
+	return infos
+}
+
+// UnitFactor returns the scale and offset that convert a value in the unit with
+// the given symbol to the base unit of its dimension (base = value*scale + offset).
+// ok is false if the unit is unknown or its conversion is not linear.
+func UnitFactor(dimension, symbol string) (scale, offset float64, ok bool) {
+	for _, u := range registeredUnits(dimension) {
+		if u.Symbol() == symbol {
+			return unitFactors(u)
+		}
+	}
+	return 0, 0, false
+}
+
+// SuggestUnit returns the registered unit of dimension in which baseValue (in
+// the base unit) is written with the fewest digits, e.g. 1500 m as 1.5 km and
+// 0.0005 m as 0.5 mm. Ties prefer a value between 1 and 1000, then the larger
+// unit (1 MiB over 8 Mibit). Converted values are rounded to 12 significant
+// digits to hide floating-point noise. Units without a linear factor, such as
+// L/100km, are not suggested. ok is false for unknown dimensions and
+// non-finite values.
+func SuggestUnit(dimension string, baseValue float64) (symbol string, converted float64, ok bool) {
+	if math.IsNaN(baseValue) || math.IsInf(baseValue, 0) {
+		return "", 0, false
+	}
+
+	bestDigits, bestInRange := 0, false
+	for _, u := range registeredUnits(dimension) {
+		if _, _, linear := unitFactors(u); !linear {
+			continue
+		}
+		v, err := strconv.ParseFloat(strconv.FormatFloat(u.ConvertFromBaseUnit(baseValue), 'g', 12, 64), 64)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			continue
+		}
+		digits := countDigits(v)
+		inRange := math.Abs(v) >= 1 && math.Abs(v) < 1000
+		better := digits < bestDigits ||
+			(digits == bestDigits && inRange && !bestInRange) ||
+			(digits == bestDigits && inRange == bestInRange && math.Abs(v) < math.Abs(converted))
+		if !ok || better {
+			symbol, converted, ok = u.Symbol(), v, true
+			bestDigits, bestInRange = digits, inRange
+		}
+	}
+	return symbol, converted, ok
+}
+
+// countDigits returns the number of decimal digits needed to write v in
+// positional notation, counting leading and trailing zeros ("0.005" has 4)
+func countDigits(v float64) int {
+	n := 0
+	for _, c := range strconv.FormatFloat(v, 'f', -1, 64) {
+		if c >= '0' && c <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+// ConversionFactor is the linear transform of a unit to the base unit of its
+// dimension: base = value*Scale + Offset
+type ConversionFactor struct {
+	Scale  float64 `json:"scale"`
+	Offset float64 `json:"offset"`
+}
+
+// ExportConversionTable returns, per dimension, the conversion factor of every
+// unit keyed by symbol, e.g. table["length"]["km"] = {1000, 0}. The table is
+// JSON-serializable so clients can convert without the Go backend. Units whose
+// conversion is not linear (L/100km) are omitted.
+func ExportConversionTable() map[string]map[string]ConversionFactor {
+	table := make(map[string]map[string]ConversionFactor, len(dimensions))
+	for _, dim := range ListDimensions() {
+		factors := make(map[string]ConversionFactor)
+		for _, u := range registeredUnits(dim) {
+			if scale, offset, ok := unitFactors(u); ok {
+				factors[u.Symbol()] = ConversionFactor{Scale: scale, Offset: offset}
+			}
+		}
+		table[dim] = factors
+	}
+	return table
+}
+
+// unitFactors returns the linear transform of a unit to its base unit
+func unitFactors(u Category) (scale, offset float64, ok bool) {
+	if lf, isLinear := u.(interface {
+		linearFactors() (float64, float64, bool)
+	}); isLinear {
+		return lf.linearFactors()
+	}
+	// Fall back to probing the conversion for units defined outside the package
+	offset = u.ConvertToBaseUnit(0)
+	return u.ConvertToBaseUnit(1) - offset, offset, true
+}
+
+// registeredUnits returns the canonical units of a dimension (aliases such as
+// "C" for "°C" are skipped), sorted by their base-unit scale
+func registeredUnits(dimension string) []Category {
+	r, ok := registries[dimension]
+	if !ok {
+		return nil
+	}
+	return canonicalUnits(r.bySymbol)
+}
+
+// dimensionRegistry holds the lookups of a single dimension, built once so that
+// resolving a unit is a map access rather than a switch over every dimension
+type dimensionRegistry struct {
+	bySymbol  map[string]Category
+	byName    map[string]Category // compact key without the "dimension_" prefix
+	base      Category            // the dimension's base unit
+	unmarshal func(data []byte) (any, error)
+	quantity  func(value float64, unit Category) any // builds the typed Quantity for the dimension
+}
+
+// newDimensionRegistry builds the registry of a dimension from its typed
+// symbol and key maps and its unmarshal function
+func newDimensionRegistry[U Category](dimension string, bySymbol, byKey map[string]U, unmarshal func([]byte) (Quantity[U], error)) *dimensionRegistry {
+	r := &dimensionRegistry{
+		bySymbol: make(map[string]Category, len(bySymbol)),
+		byName:   make(map[string]Category, len(byKey)),
+		unmarshal: func(data []byte) (any, error) {
+			m, err := unmarshal(data)
+			if err != nil {
+				return nil, err
+			}
+			return m, nil
+		},
+		quantity: func(value float64, unit Category) any {
+			return New(value, unit.(U))
+		},
+	}
+	for symbol, u := range bySymbol {
+		r.bySymbol[symbol] = u
+		if u.IsBaseUnit() {
+			r.base = u
+		}
+	}
+	prefix := dimension + "_"
+	for key, u := range byKey {
+		if name, ok := strings.CutPrefix(key, prefix); ok {
+			r.byName[name] = u
+		}
+	}
+	return r
+}
+
+// resolve finds a unit of the dimension by symbol (Unicode or ASCII form),
+// then by name, e.g. "°C", "degC", or "Celsius"
+func (r *dimensionRegistry) resolve(s string) (Category, bool) {
+	if u, ok := r.bySymbol[s]; ok {
+		return u, true
+	}
+	if u, ok := r.bySymbol[fromASCIISymbol(s)]; ok {
+		return u, true
+	}
+	u, ok := r.byName[toSnakeCase(s)]
+	return u, ok
+}
+
+// registries maps each dimension to its precomputed lookups
+var registries = map[string]*dimensionRegistry{
+	"temperature":                   newDimensionRegistry("temperature", temperatureUnitsBySymbol, temperatureUnitsByKey, UnmarshalTemperature),
+	"pressure":                      newDimensionRegistry("pressure", pressureUnitsBySymbol, pressureUnitsByKey, UnmarshalPressure),
+	"flowrate":                      newDimensionRegistry("flowrate", flowRateUnitsBySymbol, flowRateUnitsByKey, UnmarshalFlowRate),
+	"power":                         newDimensionRegistry("power", powerUnitsBySymbol, powerUnitsByKey, UnmarshalPower),
+	"energy":                        newDimensionRegistry("energy", energyUnitsBySymbol, energyUnitsByKey, UnmarshalEnergy),
+	"length":                        newDimensionRegistry("length", lengthUnitsBySymbol, lengthUnitsByKey, UnmarshalLength),
+	"mass":                          newDimensionRegistry("mass", massUnitsBySymbol, massUnitsByKey, UnmarshalMass),
+	"duration":                      newDimensionRegistry("duration", durationUnitsBySymbol, durationUnitsByKey, UnmarshalDuration),
+	"angle":                         newDimensionRegistry("angle", angleUnitsBySymbol, angleUnitsByKey, UnmarshalAngle),
+	"area":                          newDimensionRegistry("area", areaUnitsBySymbol, areaUnitsByKey, UnmarshalArea),
+	"volume":                        newDimensionRegistry("volume", volumeUnitsBySymbol, volumeUnitsByKey, UnmarshalVolume),
+	"acceleration":                  newDimensionRegistry("acceleration", accelerationUnitsBySymbol, accelerationUnitsByKey, UnmarshalAcceleration),
+	"concentration":                 newDimensionRegistry("concentration", concentrationUnitsBySymbol, concentrationUnitsByKey, UnmarshalConcentration),
+	"dispersion":                    newDimensionRegistry("dispersion", dispersionUnitsBySymbol, dispersionUnitsByKey, UnmarshalDispersion),
+	"speed":                         newDimensionRegistry("speed", speedUnitsBySymbol, speedUnitsByKey, UnmarshalSpeed),
+	"electric_charge":               newDimensionRegistry("electric_charge", electricChargeUnitsBySymbol, electricChargeUnitsByKey, UnmarshalElectricCharge),
+	"electric_current":              newDimensionRegistry("electric_current", electricCurrentUnitsBySymbol, electricCurrentUnitsByKey, UnmarshalElectricCurrent),
+	"electric_potential_difference": newDimensionRegistry("electric_potential_difference", electricPotentialDifferenceUnitsBySymbol, electricPotentialDifferenceUnitsByKey, UnmarshalElectricPotentialDifference),
+	"frequency":                     newDimensionRegistry("frequency", frequencyUnitsBySymbol, frequencyUnitsByKey, UnmarshalFrequency),
+	"illuminance":                   newDimensionRegistry("illuminance", illuminanceUnitsBySymbol, illuminanceUnitsByKey, UnmarshalIlluminance),
+	"information":                   newDimensionRegistry("information", informationUnitsBySymbol, informationUnitsByKey, UnmarshalInformation),
+	"fuel_efficiency":               newDimensionRegistry("fuel_efficiency", fuelEfficiencyUnitsBySymbol, fuelEfficiencyUnitsByKey, UnmarshalFuelEfficiency),
+	"ratio":                         newDimensionRegistry("ratio", ratioUnitsBySymbol, ratioUnitsByKey, UnmarshalRatio),
+	"density":                       newDimensionRegistry("density", densityUnitsBySymbol, densityUnitsByKey, UnmarshalDensity),
+	"electric_field":                newDimensionRegistry("electric_field", electricFieldUnitsBySymbol, electricFieldUnitsByKey, UnmarshalElectricField),
+	"magnetic_flux_density":         newDimensionRegistry("magnetic_flux_density", magneticFluxDensityUnitsBySymbol, magneticFluxDensityUnitsByKey, UnmarshalMagneticFluxDensity),
+	"angular_velocity":              newDimensionRegistry("angular_velocity", angularVelocityUnitsBySymbol, angularVelocityUnitsByKey, UnmarshalAngularVelocity),
+	"area_density":                  newDimensionRegistry("area_density", areaDensityUnitsBySymbol, areaDensityUnitsByKey, UnmarshalAreaDensity),
+	"linear_density":                newDimensionRegistry("linear_density", linearDensityUnitsBySymbol, linearDensityUnitsByKey, UnmarshalLinearDensity),
+	"heat_capacity":                 newDimensionRegistry("heat_capacity", heatCapacityUnitsBySymbol, heatCapacityUnitsByKey, UnmarshalHeatCapacity),
+	"specific_heat_capacity":        newDimensionRegistry("specific_heat_capacity", specificHeatCapacityUnitsBySymbol, specificHeatCapacityUnitsByKey, UnmarshalSpecificHeatCapacity),
+}
+
+// ValidateRegistries reports symbols that are shared by different units of
+// the same dimension, and symbols registered in more than one dimension.
+// Cross-dimension sharing is often intentional ("g" is both gram and g-force),
+// but it makes symbol-only lookups ambiguous, so maintainers should review
+// every reported collision. The result is sorted and nil if there are none.
+// Collisions that are not listed in knownSymbolCollisions make the package
+// panic on initialization.
+func ValidateRegistries() []error {
+	return validateRegistries(registries)
+}
+
+// knownSymbolCollisions are the collisions reported by ValidateRegistries
+// that have been reviewed and kept on purpose; the dimension disambiguates them
+var knownSymbolCollisions = map[string]bool{
+	`symbol "C" is registered in dimensions electric_charge, temperature`: true, // coulomb, Celsius alias
+	`symbol "g" is registered in dimensions acceleration, mass`:           true, // g-force, gram
+	`symbol "ppb" is registered in dimensions concentration, dispersion`:  true,
+	`symbol "ppm" is registered in dimensions concentration, dispersion`:  true,
+}
+
+// init rejects symbol collisions that have not been reviewed, so a unit added
+// with a clashing symbol fails every test run rather than breaking lookups
+func init() {
+	if err := checkRegistries(registries); err != nil {
+		panic("unit: " + err.Error())
+	}
+}
+
+// checkRegistries returns the first collision in regs that is not listed in
+// knownSymbolCollisions
+func checkRegistries(regs map[string]*dimensionRegistry) error {
+	for _, err := range validateRegistries(regs) {
+		if !knownSymbolCollisions[err.Error()] {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRegistries implements ValidateRegistries over the given registries
+func validateRegistries(regs map[string]*dimensionRegistry) []error {
+	var errs []error
+	dimensionsBySymbol := make(map[string][]string)
+
+	dims := make([]string, 0, len(regs))
+	for dim := range regs {
+		dims = append(dims, dim)
+	}
+	sort.Strings(dims)
+	for _, dim := range dims {
+		r := regs[dim]
+
+		// Distinct units (by name) reachable through the registry that share a symbol
+		namesBySymbol := make(map[string]map[string]bool)
+		addUnit := func(u Category) {
+			if namesBySymbol[u.Symbol()] == nil {
+				namesBySymbol[u.Symbol()] = make(map[string]bool)
+			}
+			namesBySymbol[u.Symbol()][u.Name()] = true
+		}
+		for symbol, u := range r.bySymbol {
+			addUnit(u)
+			dimensionsBySymbol[symbol] = append(dimensionsBySymbol[symbol], dim)
+		}
+		for _, u := range r.byName {
+			addUnit(u)
+		}
+		for symbol, names := range namesBySymbol {
+			if len(names) > 1 {
+				errs = append(errs, fmt.Errorf("%s: symbol %q is used by units %s",
+					dim, symbol, strings.Join(sortedKeys(names), ", ")))
+			}
+		}
+	}
+
+	for symbol, dims := range dimensionsBySymbol {
+		if len(dims) > 1 {
+			sort.Strings(dims)
+			errs = append(errs, fmt.Errorf("symbol %q is registered in dimensions %s",
+				symbol, strings.Join(dims, ", ")))
+		}
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errs
+}
+
+// sortedKeys returns the keys of a set in ascending order
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// canonicalUnits collects the units of a symbol registry whose key is their
+// own symbol, sorted by base-unit scale and then by symbol
+func canonicalUnits[U Category](bySymbol map[string]U) []Category {
+	units := make([]Category, 0, len(bySymbol))
+	for symbol, u := range bySymbol {
+		if symbol == u.Symbol() {
+			units = append(units, u)
+		}
+	}
+	sort.Slice(units, func(i, j int) bool {
+		si, _, _ := unitFactors(units[i])
+		sj, _, _ := unitFactors(units[j])
+		if si != sj {
+			return si < sj
+		}
+		return units[i].Symbol() < units[j].Symbol()
+	})
+	return units
+}
@@ -0,0 +1,338 @@
+package unit
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestListDimensions(t *testing.T) {
+	dims := ListDimensions()
+	if len(dims) == 0 {
+		t.Fatal("ListDimensions returned no dimensions")
+	}
+
+	for _, dim := range dims {
+		if len(ListUnits(dim)) == 0 {
+			t.Errorf("Dimension %q has no registered units", dim)
+		}
+	}
+
+	if ListUnits("unknown_dimension") != nil {
+		t.Error("Expected nil for unknown dimension")
+	}
+}
+
+func TestListUnits(t *testing.T) {
+	units := ListUnits("temperature")
+	if len(units) != 3 {
+		t.Fatalf("Expected 3 temperature units (aliases excluded), got %d", len(units))
+	}
+
+	for _, u := range units {
+		if u.Dimension != "temperature" {
+			t.Errorf("Unit %s has dimension %q, expected temperature", u.Symbol, u.Dimension)
+		}
+		if u.Symbol == "°C" && !u.IsBase {
+			t.Errorf("Expected °C to be the base unit")
+		}
+	}
+}
+
+func TestUnitFactor(t *testing.T) {
+	testCases := []struct {
+		name           string
+		dimension      string
+		symbol         string
+		expectedScale  float64
+		expectedOffset float64
+	}{
+		{"Meter", "length", "m", 1, 0},
+		{"Kilometer", "length", "km", 1000, 0},
+		{"Celsius (base)", "temperature", "°C", 1, 0},
+		{"Kelvin", "temperature", "K", 1, -273.15},
+		{"Kilowatt-hour", "energy", "kWh", 3600000, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scale, offset, ok := UnitFactor(tc.dimension, tc.symbol)
+			if !ok {
+				t.Fatalf("UnitFactor(%q, %q) not found", tc.dimension, tc.symbol)
+			}
+			if math.Abs(scale-tc.expectedScale) > 1e-12 || math.Abs(offset-tc.expectedOffset) > 1e-12 {
+				t.Errorf("UnitFactor(%q, %q) = (%g, %g), expected (%g, %g)",
+					tc.dimension, tc.symbol, scale, offset, tc.expectedScale, tc.expectedOffset)
+			}
+		})
+	}
+
+	if _, _, ok := UnitFactor("length", "parsec"); ok {
+		t.Error("Expected ok=false for unknown unit")
+	}
+	if _, _, ok := UnitFactor("fuel_efficiency", "L/100km"); ok {
+		t.Error("Expected ok=false for non-linear L/100km")
+	}
+}
+
+func TestRegistriesResolveAllDimensions(t *testing.T) {
+	for _, dim := range ListDimensions() {
+		r, ok := registries[dim]
+		if !ok {
+			t.Errorf("Dimension %q has no registry", dim)
+			continue
+		}
+
+		for _, u := range registeredUnits(dim) {
+			got, err := lookupUnit[Category](dim, u.Symbol())
+			if err != nil {
+				t.Errorf("lookupUnit(%q, %q): %v", dim, u.Symbol(), err)
+			} else if !got.Equals(u) {
+				t.Errorf("lookupUnit(%q, %q) = %s", dim, u.Symbol(), got.Symbol())
+			}
+		}
+
+		for name, u := range r.byName {
+			got, err := lookupUnitByName[Category](dim, name)
+			if err != nil {
+				t.Errorf("lookupUnitByName(%q, %q): %v", dim, name, err)
+			} else if !got.Equals(u) {
+				t.Errorf("lookupUnitByName(%q, %q) = %s", dim, name, got.Symbol())
+			}
+		}
+	}
+
+	if _, err := lookupUnitByName[LengthUnit]("length", "parsec"); err == nil {
+		t.Error("Expected error for unknown unit key")
+	}
+	if _, err := lookupUnit[LengthUnit]("unknown_dimension", "m"); err == nil {
+		t.Error("Expected error for unknown dimension")
+	}
+	if _, err := lookupUnit[LengthUnit]("mass", "kg"); err == nil {
+		t.Error("Expected error for unit type mismatch")
+	}
+}
+
+func TestNoZeroOrNaNFactors(t *testing.T) {
+	samples := []float64{1, 42.5, 1e-3}
+
+	for _, dim := range ListDimensions() {
+		for _, info := range ListUnits(dim) {
+			t.Run(dim+"/"+info.Symbol, func(t *testing.T) {
+				// Reciprocal units such as L/100km have no linear scale
+				if info.Linear && (info.Scale == 0 || math.IsNaN(info.Scale) || math.IsInf(info.Scale, 0)) {
+					t.Fatalf("unit %s has scale %g", info.Name, info.Scale)
+				}
+
+				u, ok := registries[dim].resolve(info.Symbol)
+				if !ok {
+					t.Fatalf("symbol %q does not resolve", info.Symbol)
+				}
+				for _, sample := range samples {
+					base := u.ConvertToBaseUnit(sample)
+					if base == 0 || math.IsNaN(base) || math.IsInf(base, 0) {
+						t.Errorf("%g %s is %g in the base unit", sample, info.Symbol, base)
+						continue
+					}
+					back := u.ConvertFromBaseUnit(base)
+					if math.IsNaN(back) || math.Abs(back-sample) > 1e-9*sample {
+						t.Errorf("%g %s converts back from base as %g", sample, info.Symbol, back)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkLookupUnitByName(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := lookupUnitByName[FuelEfficiencyUnit]("fuel_efficiency", "miles_per_gallon"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestConversionTable(t *testing.T) {
+	for _, dim := range ListDimensions() {
+		infos := ListUnits(dim)
+
+		var base Category
+		for _, info := range infos {
+			if info.IsBase {
+				base, _ = lookupUnit[Category](dim, info.Symbol)
+			}
+		}
+		if base == nil {
+			t.Errorf("Dimension %q has no base unit", dim)
+			continue
+		}
+
+		for _, info := range infos {
+			t.Run(dim+"/"+info.Name, func(t *testing.T) {
+				u, err := lookupUnit[Category](dim, info.Symbol)
+				if err != nil {
+					t.Fatalf("lookupUnit(%q, %q): %v", dim, info.Symbol, err)
+				}
+
+				// Round-trip through the base unit must be the identity
+				original := Quantity[Category]{Value: 42.5, Unit: u}
+				roundTrip := original.ConvertTo(base).ConvertTo(u)
+				if math.Abs(roundTrip.Value-original.Value) > 1e-9*math.Abs(original.Value) {
+					t.Errorf("Round-trip %s -> %s -> %s drifted: got %g, expected %g",
+						u.Symbol(), base.Symbol(), u.Symbol(), roundTrip.Value, original.Value)
+				}
+
+				// The key emitted by the serializers must resolve back to the unit
+				key := unitKey(dim, info.Name)
+				byKey, err := lookupUnitByName[Category](dim, strings.TrimPrefix(key, dim+"_"))
+				if err != nil {
+					t.Fatalf("Key %q is not in the by-key registry: %v", key, err)
+				}
+				if !byKey.Equals(u) {
+					t.Errorf("Key %q resolves to %s, expected %s", key, byKey.Symbol(), u.Symbol())
+				}
+			})
+		}
+	}
+}
+
+func TestValidateRegistries(t *testing.T) {
+	// Intentional collisions: the dimension disambiguates these symbols
+	expected := []string{
+		`symbol "C" is registered in dimensions electric_charge, temperature`, // coulomb, Celsius alias
+		`symbol "g" is registered in dimensions acceleration, mass`,           // g-force, gram
+		`symbol "ppb" is registered in dimensions concentration, dispersion`,
+		`symbol "ppm" is registered in dimensions concentration, dispersion`,
+	}
+
+	errs := ValidateRegistries()
+	got := make([]string, len(errs))
+	for i, err := range errs {
+		got[i] = err.Error()
+		if !knownSymbolCollisions[got[i]] {
+			t.Errorf("collision %q is not in knownSymbolCollisions", got[i])
+		}
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("ValidateRegistries() reported:\n%s\nexpected:\n%s",
+			strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+	if len(knownSymbolCollisions) != len(expected) {
+		t.Errorf("knownSymbolCollisions has %d entries, expected %d", len(knownSymbolCollisions), len(expected))
+	}
+}
+
+func TestValidateRegistriesReportsSameDimensionCollision(t *testing.T) {
+	// Validate a copy of the mass registry so the global one is untouched
+	mass := *registries["mass"]
+	mass.byName = make(map[string]Category, len(registries["mass"].byName)+1)
+	for name, u := range registries["mass"].byName {
+		mass.byName[name] = u
+	}
+	mass.byName["shipping_ton"] = MassUnit{BaseUnit: NewBaseUnit("mass", "ton", "Shipping Ton", 1000, 0, false)}
+
+	expected := `mass: symbol "ton" is used by units Shipping Ton, Ton`
+	errs := validateRegistries(map[string]*dimensionRegistry{"mass": &mass})
+	if len(errs) != 1 || errs[0].Error() != expected {
+		t.Errorf("validateRegistries() = %v, expected [%s]", errs, expected)
+	}
+	if err := checkRegistries(map[string]*dimensionRegistry{"mass": &mass}); err == nil {
+		t.Error("checkRegistries() accepted an unreviewed collision")
+	}
+	if err := checkRegistries(registries); err != nil {
+		t.Errorf("checkRegistries(registries) = %v", err)
+	}
+	if _, ok := registries["mass"].byName["shipping_ton"]; ok {
+		t.Error("validating a copy modified the global mass registry")
+	}
+}
+
+func TestExportConversionTable(t *testing.T) {
+	table := ExportConversionTable()
+
+	testCases := []struct {
+		dimension string
+		symbol    string
+		expected  ConversionFactor
+	}{
+		{"length", "m", ConversionFactor{Scale: 1, Offset: 0}},
+		{"length", "km", ConversionFactor{Scale: 1000, Offset: 0}},
+		{"temperature", "°C", ConversionFactor{Scale: 1, Offset: 0}},
+		{"temperature", "°F", ConversionFactor{Scale: 5.0 / 9.0, Offset: -32.0 * 5.0 / 9.0}},
+		{"temperature", "K", ConversionFactor{Scale: 1, Offset: -273.15}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := table[tc.dimension][tc.symbol]
+		if !ok {
+			t.Errorf("table[%q][%q] missing", tc.dimension, tc.symbol)
+			continue
+		}
+		if math.Abs(got.Scale-tc.expected.Scale) > 1e-12 || math.Abs(got.Offset-tc.expected.Offset) > 1e-12 {
+			t.Errorf("table[%q][%q] = %+v, expected %+v", tc.dimension, tc.symbol, got, tc.expected)
+		}
+	}
+
+	// Replicating a conversion from the table: 98.6 °F -> 37 °C
+	f := table["temperature"]["°F"]
+	if celsius := 98.6*f.Scale + f.Offset; math.Abs(celsius-37) > 1e-9 {
+		t.Errorf("98.6 °F via table = %g °C, expected 37", celsius)
+	}
+
+	if _, ok := table["fuel_efficiency"]["L/100km"]; ok {
+		t.Error("Non-linear L/100km should not be exported")
+	}
+	if len(table) != len(ListDimensions()) {
+		t.Errorf("table has %d dimensions, expected %d", len(table), len(ListDimensions()))
+	}
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"km":{"scale":1000,"offset":0}`) {
+		t.Errorf("Unexpected JSON for km: %s", data)
+	}
+}
+
+func TestSuggestUnit(t *testing.T) {
+	testCases := []struct {
+		dimension string
+		baseValue float64
+		symbol    string
+		converted float64
+	}{
+		{"length", 1500, "km", 1.5},
+		{"length", 0.0005, "mm", 0.5},
+		{"length", 1, "m", 1},
+		{"length", 0.25, "cm", 25},
+		{"length", 0.3048, "ft", 1},
+		{"mass", 2500, "t", 2.5},
+		{"duration", 7200, "h", 2},
+		{"information", 1048576, "MiB", 1},
+	}
+
+	for _, tc := range testCases {
+		symbol, converted, ok := SuggestUnit(tc.dimension, tc.baseValue)
+		if !ok || symbol != tc.symbol || math.Abs(converted-tc.converted) > 1e-12 {
+			t.Errorf("SuggestUnit(%q, %g) = %q, %g, %v; expected %q, %g",
+				tc.dimension, tc.baseValue, symbol, converted, ok, tc.symbol, tc.converted)
+		}
+	}
+
+	// L/100km is skipped: 0 km/L has no finite reciprocal
+	for _, baseValue := range []float64{0, 20} {
+		if symbol, _, ok := SuggestUnit("fuel_efficiency", baseValue); !ok || symbol == "L/100km" {
+			t.Errorf("SuggestUnit(fuel_efficiency, %g) = %q, %v; expected a linear unit", baseValue, symbol, ok)
+		}
+	}
+
+	if _, _, ok := SuggestUnit("torque", 1); ok {
+		t.Error("Expected ok=false for unknown dimension")
+	}
+	if _, _, ok := SuggestUnit("length", math.Inf(1)); ok {
+		t.Error("Expected ok=false for infinite value")
+	}
+}
@@ -9,20 +9,23 @@ type VolumeUnit struct {
 
 // Volume contains predefined volume units
 var Volume = struct {
-	CubicMeter      VolumeUnit
-	CubicKilometer  VolumeUnit
-	CubicCentimeter VolumeUnit
-	CubicMillimeter VolumeUnit
-	Liter           VolumeUnit
-	Milliliter      VolumeUnit
-	CubicInch       VolumeUnit
-	CubicFoot       VolumeUnit
-	CubicYard       VolumeUnit
-	Gallon          VolumeUnit
-	Quart           VolumeUnit
-	Pint            VolumeUnit
-	Cup             VolumeUnit
-	FluidOunce      VolumeUnit
+	CubicMeter         VolumeUnit
+	CubicKilometer     VolumeUnit
+	CubicCentimeter    VolumeUnit
+	CubicMillimeter    VolumeUnit
+	Liter              VolumeUnit
+	Milliliter         VolumeUnit
+	CubicInch          VolumeUnit
+	CubicFoot          VolumeUnit
+	CubicYard          VolumeUnit
+	Gallon             VolumeUnit
+	Quart              VolumeUnit
+	Pint               VolumeUnit
+	Cup                VolumeUnit
+	FluidOunce         VolumeUnit
+	ImperialPint       VolumeUnit
+	ImperialFluidOunce VolumeUnit
+	BoardFoot          VolumeUnit
 }{
 	CubicMeter: VolumeUnit{
 		BaseUnit: NewBaseUnit(
@@ -149,7 +152,7 @@ var Volume = struct {
 			"volume",
 			"cup",
 			"Cup",
-			0.000236588236, // 1 cup = 0.000236588236 m³ (US cup)
+			0.0002365882365, // 1 cup = 0.0002365882365 m³ (US cup, 1/16 gal)
 			0.0,
 			false,
 		),
@@ -164,9 +167,48 @@ var Volume = struct {
 			false,
 		),
 	},
+	ImperialPint: VolumeUnit{
+		BaseUnit: NewBaseUnit(
+			"volume",
+			"imp pt",
+			"Imperial Pint",
+			0.00056826125, // 1 imp pt = 0.00056826125 m³ (1/8 imperial gallon)
+			0.0,
+			false,
+		),
+	},
+	ImperialFluidOunce: VolumeUnit{
+		BaseUnit: NewBaseUnit(
+			"volume",
+			"imp fl oz",
+			"Imperial Fluid Ounce",
+			0.0000284130625, // 1 imp fl oz = 0.0000284130625 m³ (1/20 imperial pint)
+			0.0,
+			false,
+		),
+	},
+	BoardFoot: VolumeUnit{
+		BaseUnit: NewBaseUnit(
+			"volume",
+			"bf",
+			"Board Foot",
+			0.002359737216, // 1 bf = 144 in³ = 0.002359737216 m³
+			0.0,
+			false,
+		),
+	},
 }
 
 // NewVolume creates a new volume quantity
 func NewVolume(value float64, unit VolumeUnit) Quantity[VolumeUnit] {
 	return New(value, unit)
 }
+
+// BoardFeet returns the lumber volume of a board with the given nominal
+// dimensions, in board feet (1 in × 12 in × 1 ft = 1 bf)
+func BoardFeet(thickness, width, length Quantity[LengthUnit]) Quantity[VolumeUnit] {
+	cubicMeters := thickness.Unit.ConvertToBaseUnit(thickness.Value) *
+		width.Unit.ConvertToBaseUnit(width.Value) *
+		length.Unit.ConvertToBaseUnit(length.Value)
+	return NewVolume(Volume.BoardFoot.ConvertFromBaseUnit(cubicMeters), Volume.BoardFoot)
+}
@@ -0,0 +1,140 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestImperialVolumeUnits(t *testing.T) {
+	// Imperial pint is larger than the US pint: 1 imp pt ≈ 1.20095 US pt
+	impPint := NewVolume(1.0, Volume.ImperialPint)
+	usPint := impPint.ConvertTo(Volume.Pint)
+	expected := 0.00056826125 / 0.000473176473
+	if math.Abs(usPint.Value-expected) > 1e-9 {
+		t.Errorf("Imperial pint conversion failed: got %g pt, expected %g pt", usPint.Value, expected)
+	}
+
+	// Imperial fluid ounce is smaller than the US fluid ounce: 1 imp fl oz ≈ 0.96076 US fl oz
+	impOz := NewVolume(1.0, Volume.ImperialFluidOunce)
+	usOz := impOz.ConvertTo(Volume.FluidOunce)
+	expected = 0.0000284130625 / 0.0000295735295625
+	if math.Abs(usOz.Value-expected) > 1e-9 {
+		t.Errorf("Imperial fluid ounce conversion failed: got %g fl oz, expected %g fl oz", usOz.Value, expected)
+	}
+
+	// 20 imperial fluid ounces make an imperial pint
+	pint := NewVolume(20.0, Volume.ImperialFluidOunce).ConvertTo(Volume.ImperialPint)
+	if math.Abs(pint.Value-1.0) > 1e-9 {
+		t.Errorf("Expected 20 imp fl oz = 1 imp pt, got %g imp pt", pint.Value)
+	}
+
+	// US units are unchanged
+	liters := NewVolume(1.0, Volume.Pint).ConvertTo(Volume.Liter)
+	if math.Abs(liters.Value-0.473176473) > 1e-9 {
+		t.Errorf("US pint changed: got %g L, expected 0.473176473 L", liters.Value)
+	}
+}
+
+func TestImperialVolumeParsingAndSerialization(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected VolumeUnit
+	}{
+		{"2 imp pt", Volume.ImperialPint},
+		{"2 imperial pints", Volume.ImperialPint},
+		{"2 imp fl oz", Volume.ImperialFluidOunce},
+		{"2 fl oz", Volume.FluidOunce},
+		{"2 pt", Volume.Pint},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			volume, err := ParseVolume(tc.input)
+			if err != nil {
+				t.Fatalf("Failed to parse %q: %v", tc.input, err)
+			}
+			if volume.Value != 2.0 || !volume.Unit.Equals(tc.expected) {
+				t.Errorf("ParseVolume(%q) = %v, expected 2 %s", tc.input, volume, tc.expected.Symbol())
+			}
+
+			for _, format := range []SerializationFormat{FormatFull, FormatCompact, FormatMinimal} {
+				data, err := MarshalWithFormat(volume, format)
+				if err != nil {
+					t.Fatalf("MarshalWithFormat failed: %v", err)
+				}
+				volume2, err := UnmarshalVolume(data)
+				if err != nil {
+					t.Fatalf("Failed to unmarshal %s: %v", data, err)
+				}
+				if !volume2.Unit.Equals(tc.expected) || volume2.Value != volume.Value {
+					t.Errorf("Round-trip of %s failed: got %v, expected %v", data, volume2, volume)
+				}
+			}
+		})
+	}
+}
+
+func TestVolumeUSConsistency(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     Quantity[VolumeUnit]
+		to       VolumeUnit
+		expected float64
+	}{
+		{"1 gal to qt", NewVolume(1, Volume.Gallon), Volume.Quart, 4},
+		{"1 gal to pt", NewVolume(1, Volume.Gallon), Volume.Pint, 8},
+		{"1 gal to cup", NewVolume(1, Volume.Gallon), Volume.Cup, 16},
+		{"1 gal to fl oz", NewVolume(1, Volume.Gallon), Volume.FluidOunce, 128},
+		{"1 qt to pt", NewVolume(1, Volume.Quart), Volume.Pint, 2},
+		{"1 pt to cup", NewVolume(1, Volume.Pint), Volume.Cup, 2},
+		{"1 cup to fl oz", NewVolume(1, Volume.Cup), Volume.FluidOunce, 8},
+		{"1 gal to in³", NewVolume(1, Volume.Gallon), Volume.CubicInch, 231},
+		{"1 gal to L", NewVolume(1, Volume.Gallon), Volume.Liter, 3.785411784},
+		{"1 m³ to L", NewVolume(1, Volume.CubicMeter), Volume.Liter, 1000},
+		{"1 L to mL", NewVolume(1, Volume.Liter), Volume.Milliliter, 1000},
+		{"1 mL to cm³", NewVolume(1, Volume.Milliliter), Volume.CubicCentimeter, 1},
+		{"1 ft³ to in³", NewVolume(1, Volume.CubicFoot), Volume.CubicInch, 1728},
+		{"1 yd³ to ft³", NewVolume(1, Volume.CubicYard), Volume.CubicFoot, 27},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.from.ConvertTo(tc.to)
+			if math.Abs(got.Value-tc.expected) > 1e-12*math.Max(1, tc.expected) {
+				t.Errorf("%v = %.15g %s, expected %v", tc.from, got.Value, tc.to.Symbol(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestBoardFoot(t *testing.T) {
+	if got := NewVolume(1, Volume.BoardFoot).ConvertTo(Volume.CubicInch).Value; math.Abs(got-144) > 1e-9 {
+		t.Errorf("1 bf = %g in³, expected 144", got)
+	}
+
+	board := BoardFeet(NewLength(1, Length.Inch), NewLength(12, Length.Inch), NewLength(1, Length.Foot))
+	if !board.Unit.Equals(Volume.BoardFoot) || math.Abs(board.Value-1) > 1e-9 {
+		t.Errorf("1 in × 12 in × 1 ft = %v, expected 1 bf", board)
+	}
+	// A 2×4 (nominal) that is 8 ft long is 5 1/3 bf
+	board = BoardFeet(NewLength(2, Length.Inch), NewLength(4, Length.Inch), NewLength(8, Length.Foot))
+	if math.Abs(board.Value-16.0/3) > 1e-9 {
+		t.Errorf("2 in × 4 in × 8 ft = %v, expected 5.333 bf", board)
+	}
+
+	q, err := ParseVolume("10 bf")
+	if err != nil {
+		t.Fatalf("ParseVolume(\"10 bf\") error: %v", err)
+	}
+	if got := q.ConvertTo(Volume.Liter).Value; math.Abs(got-23.59737216) > 1e-9 {
+		t.Errorf("10 bf = %g L, expected 23.59737216", got)
+	}
+
+	data, err := MarshalVolume(q)
+	if err != nil {
+		t.Fatalf("MarshalVolume error: %v", err)
+	}
+	if back, err := UnmarshalVolume(data); err != nil || !back.Unit.Equals(Volume.BoardFoot) || back.Value != 10 {
+		t.Errorf("UnmarshalVolume(%s) = %v, %v", data, back, err)
+	}
+}
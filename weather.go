@@ -0,0 +1,120 @@
+// Package unit provides a system for representing, converting, and operating on
+// physical quantities with units.
+package unit
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WindChill returns the apparent temperature felt on exposed skin using the
+// NWS wind chill formula, which is defined for temperatures at or below 50 °F
+// and wind speeds of at least 3 mph. Outside that range the air temperature is
+// returned unchanged. The result is in the unit of t.
+func WindChill(t Quantity[TemperatureUnit], wind Quantity[SpeedUnit]) Quantity[TemperatureUnit] {
+	tempF := t.ConvertTo(Temperature.Fahrenheit).Value
+	windMph := wind.ConvertTo(Speed.MilesPerHour).Value
+	if tempF > 50 || windMph < 3 {
+		return t
+	}
+
+	v := math.Pow(windMph, 0.16)
+	chillF := 35.74 + 0.6215*tempF - 35.75*v + 0.4275*tempF*v
+	return NewTemperature(chillF, Temperature.Fahrenheit).ConvertTo(t.Unit)
+}
+
+// HeatIndex returns the apparent temperature combining air temperature and
+// relative humidity, following the NWS algorithm: Steadman's simple formula
+// below 80 °F and the Rothfusz regression (with its low and high humidity
+// adjustments) above. The result is in the unit of t.
+func HeatIndex(t Quantity[TemperatureUnit], humidity Quantity[DispersionUnit]) Quantity[TemperatureUnit] {
+	tempF := t.ConvertTo(Temperature.Fahrenheit).Value
+	rh := humidity.ConvertTo(Dispersion.Percent).Value
+
+	hi := 0.5 * (tempF + 61.0 + (tempF-68.0)*1.2 + rh*0.094)
+	if (hi+tempF)/2 >= 80 {
+		hi = -42.379 + 2.04901523*tempF + 10.14333127*rh -
+			0.22475541*tempF*rh - 0.00683783*tempF*tempF -
+			0.05481717*rh*rh + 0.00122874*tempF*tempF*rh +
+			0.00085282*tempF*rh*rh - 0.00000199*tempF*tempF*rh*rh
+
+		switch {
+		case rh < 13 && tempF >= 80 && tempF <= 112:
+			hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(tempF-95))/17)
+		case rh > 85 && tempF >= 80 && tempF <= 87:
+			hi += (rh - 85) / 10 * (87 - tempF) / 5
+		}
+	}
+
+	return NewTemperature(hi, Temperature.Fahrenheit).ConvertTo(t.Unit)
+}
+
+// DewPoint returns the temperature to which air must be cooled to become
+// saturated, using the Magnus formula with the Sonntag coefficients
+// (a = 17.62, b = 243.12 °C). The result is in the unit of t.
+func DewPoint(t Quantity[TemperatureUnit], relHumidity Quantity[DispersionUnit]) Quantity[TemperatureUnit] {
+	const (
+		a = 17.62
+		b = 243.12 // °C
+	)
+	tempC := t.ConvertTo(Temperature.Celsius).Value
+	rh := relHumidity.ConvertTo(Dispersion.Percent).Value
+
+	gamma := math.Log(rh/100) + a*tempC/(b+tempC)
+	dewC := b * gamma / (a - gamma)
+	return NewTemperature(dewC, Temperature.Celsius).ConvertTo(t.Unit)
+}
+
+// Wind pairs a wind speed with the direction the wind blows from, as reported
+// in marine and aviation weather
+type Wind struct {
+	Speed     Quantity[SpeedUnit]
+	Direction Quantity[AngleUnit]
+}
+
+// String formats the wind like "12 kn from 270°", with the direction in degrees
+func (w Wind) String() string {
+	deg := w.Direction.ConvertTo(Angle.Degree).Value
+	return w.Speed.String() + " from " + strconv.FormatFloat(deg, 'g', -1, 64) + "°"
+}
+
+// ParseWind parses a wind report like "12kt 270", "12 kn 270°", or
+// "12 kn from 270°" into a Wind. The speed is parsed by ParseSpeed, and a
+// bare direction is taken as degrees.
+func ParseWind(s string) (Wind, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return Wind{}, ParseError{
+			Input: s,
+			Msg:   "invalid format, expected '<speed> <direction>' (e.g., '12kt 270')",
+			Err:   ErrMalformedValue,
+		}
+	}
+
+	// The direction is the last field, or the last two if its unit is
+	// separated from the number ("1.5 rad")
+	dirStart := len(fields) - 1
+	if !strings.ContainsAny(fields[dirStart], "0123456789") {
+		dirStart--
+	}
+	dirStr := strings.Join(fields[dirStart:], " ")
+	speedFields := fields[:dirStart]
+	if n := len(speedFields); n > 0 && strings.EqualFold(speedFields[n-1], "from") {
+		speedFields = speedFields[:n-1]
+	}
+
+	speed, err := ParseSpeed(strings.Join(speedFields, " "))
+	if err != nil {
+		return Wind{}, err
+	}
+
+	var direction Quantity[AngleUnit]
+	if deg, err := strconv.ParseFloat(dirStr, 64); err == nil {
+		direction = NewAngle(deg, Angle.Degree)
+	} else if direction, err = ParseAngle(dirStr); err != nil {
+		return Wind{}, err
+	}
+
+	return Wind{Speed: speed, Direction: direction}, nil
+}
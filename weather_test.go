@@ -0,0 +1,158 @@
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWindChill(t *testing.T) {
+	testCases := []struct {
+		name     string
+		temp     Quantity[TemperatureUnit]
+		wind     Quantity[SpeedUnit]
+		expected float64 // in the unit of temp
+	}{
+		// Reference values from the NWS wind chill chart
+		{"0 °F at 15 mph", NewTemperature(0, Temperature.Fahrenheit), NewSpeed(15, Speed.MilesPerHour), -19},
+		{"40 °F at 10 mph", NewTemperature(40, Temperature.Fahrenheit), NewSpeed(10, Speed.MilesPerHour), 34},
+		{"-20 °F at 30 mph", NewTemperature(-20, Temperature.Fahrenheit), NewSpeed(30, Speed.MilesPerHour), -53},
+		// -10 °C at 20 km/h ≈ -17.9 °C
+		{"-10 °C at 20 km/h", NewTemperature(-10, Temperature.Celsius), NewSpeed(20, Speed.KilometersPerHour), -17.9},
+		// Outside the valid range the air temperature is returned
+		{"Warm", NewTemperature(20, Temperature.Celsius), NewSpeed(30, Speed.KilometersPerHour), 20},
+		{"Calm", NewTemperature(-5, Temperature.Celsius), NewSpeed(1, Speed.MilesPerHour), -5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := WindChill(tc.temp, tc.wind)
+			if !result.Unit.Equals(tc.temp.Unit) {
+				t.Errorf("Expected result in %s, got %s", tc.temp.Unit.Symbol(), result.Unit.Symbol())
+			}
+			if math.Abs(result.Value-tc.expected) > 0.5 {
+				t.Errorf("WindChill(%v, %v) = %v, expected %g", tc.temp, tc.wind, result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestHeatIndex(t *testing.T) {
+	testCases := []struct {
+		name     string
+		temp     Quantity[TemperatureUnit]
+		humidity Quantity[DispersionUnit]
+		expected float64 // in the unit of temp
+	}{
+		// Reference values from the NWS heat index chart
+		{"90 °F at 70 %", NewTemperature(90, Temperature.Fahrenheit), NewDispersion(70, Dispersion.Percent), 106},
+		{"100 °F at 40 %", NewTemperature(100, Temperature.Fahrenheit), NewDispersion(40, Dispersion.Percent), 109},
+		{"80 °F at 40 %", NewTemperature(80, Temperature.Fahrenheit), NewDispersion(40, Dispersion.Percent), 80},
+		// Low humidity adjustment: 100 °F at 10 % ≈ 94 °F
+		{"100 °F at 10 %", NewTemperature(100, Temperature.Fahrenheit), NewDispersion(10, Dispersion.Percent), 94},
+		// 32 °C (89.6 °F) at 60 % ≈ 98.7 °F ≈ 37.1 °C
+		{"32 °C at 60 %", NewTemperature(32, Temperature.Celsius), NewDispersion(60, Dispersion.Percent), 37.1},
+		// Below 80 °F the simple formula applies: 70 °F at 50 % ≈ 69 °F
+		{"Mild", NewTemperature(70, Temperature.Fahrenheit), NewDispersion(500000, Dispersion.PartsPerMillion), 69},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := HeatIndex(tc.temp, tc.humidity)
+			if !result.Unit.Equals(tc.temp.Unit) {
+				t.Errorf("Expected result in %s, got %s", tc.temp.Unit.Symbol(), result.Unit.Symbol())
+			}
+			if math.Abs(result.Value-tc.expected) > 0.6 {
+				t.Errorf("HeatIndex(%v, %v) = %v, expected %g", tc.temp, tc.humidity, result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDewPoint(t *testing.T) {
+	testCases := []struct {
+		name     string
+		temp     Quantity[TemperatureUnit]
+		humidity Quantity[DispersionUnit]
+		expected float64 // in the unit of temp
+	}{
+		{"30 °C at 70 %", NewTemperature(30, Temperature.Celsius), NewDispersion(70, Dispersion.Percent), 23.9},
+		{"20 °C at 50 %", NewTemperature(20, Temperature.Celsius), NewDispersion(50, Dispersion.Percent), 9.3},
+		// Saturated air: the dew point equals the air temperature
+		{"Saturated", NewTemperature(15, Temperature.Celsius), NewDispersion(100, Dispersion.Percent), 15},
+		// 86 °F (30 °C) at 70 % ≈ 23.9 °C ≈ 75.0 °F
+		{"Fahrenheit", NewTemperature(86, Temperature.Fahrenheit), NewDispersion(70, Dispersion.Percent), 75.0},
+		{"Kelvin", NewTemperature(303.15, Temperature.Kelvin), NewDispersion(700000, Dispersion.PartsPerMillion), 297.05},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := DewPoint(tc.temp, tc.humidity)
+			if !result.Unit.Equals(tc.temp.Unit) {
+				t.Errorf("Expected result in %s, got %s", tc.temp.Unit.Symbol(), result.Unit.Symbol())
+			}
+			if math.Abs(result.Value-tc.expected) > 0.1 {
+				t.Errorf("DewPoint(%v, %v) = %v, expected %g", tc.temp, tc.humidity, result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestWindString(t *testing.T) {
+	w := Wind{Speed: NewSpeed(12, Speed.Knot), Direction: NewAngle(270, Angle.Degree)}
+	if got := w.String(); got != "12 kn from 270°" {
+		t.Errorf("String() = %q, expected %q", got, "12 kn from 270°")
+	}
+
+	w.Direction = NewAngle(math.Pi/2, Angle.Radian)
+	if got := w.String(); got != "12 kn from 90°" {
+		t.Errorf("String() = %q, expected %q", got, "12 kn from 90°")
+	}
+}
+
+func TestParseWind(t *testing.T) {
+	testCases := []struct {
+		input string
+		speed Quantity[SpeedUnit]
+		deg   float64
+	}{
+		{"12kt 270", NewSpeed(12, Speed.Knot), 270},
+		{"12 kn 270°", NewSpeed(12, Speed.Knot), 270},
+		{"12 kn from 270°", NewSpeed(12, Speed.Knot), 270},
+		{"5 m/s 45", NewSpeed(5, Speed.MetersPerSecond), 45},
+		{"20 mph from 1.5 rad", NewSpeed(20, Speed.MilesPerHour), 1.5 * 180 / math.Pi},
+	}
+	for _, tc := range testCases {
+		w, err := ParseWind(tc.input)
+		if err != nil {
+			t.Errorf("ParseWind(%q) error: %v", tc.input, err)
+			continue
+		}
+		if w.Speed.Value != tc.speed.Value || !w.Speed.Unit.Equals(tc.speed.Unit) {
+			t.Errorf("ParseWind(%q) speed = %v, expected %v", tc.input, w.Speed, tc.speed)
+		}
+		if deg := w.Direction.ConvertTo(Angle.Degree).Value; math.Abs(deg-tc.deg) > 1e-9 {
+			t.Errorf("ParseWind(%q) direction = %g°, expected %g°", tc.input, deg, tc.deg)
+		}
+	}
+
+	// String output parses back to the same wind
+	w := Wind{Speed: NewSpeed(8, Speed.Knot), Direction: NewAngle(135, Angle.Degree)}
+	if back, err := ParseWind(w.String()); err != nil || back.String() != w.String() {
+		t.Errorf("ParseWind(%q) = %v, %v", w.String(), back, err)
+	}
+
+	for _, input := range []string{"12kt", "from 270", "12 xyz 270", "12kt north"} {
+		if _, err := ParseWind(input); err == nil {
+			t.Errorf("ParseWind(%q) expected error", input)
+		}
+	}
+}
+
+func TestParseSpeedKnotAliases(t *testing.T) {
+	for _, input := range []string{"12 kn", "12kt", "12 kts", "12 knots"} {
+		q, err := ParseSpeed(input)
+		if err != nil || q.Value != 12 || !q.Unit.Equals(Speed.Knot) {
+			t.Errorf("ParseSpeed(%q) = %v, %v; expected 12 kn", input, q, err)
+		}
+	}
+}